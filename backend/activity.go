@@ -0,0 +1,36 @@
+package neo
+
+import "time"
+
+// Activity counts the kills and losses of an entity by the day of the week and the hour of the day, in EVE time,
+// that they happened at. Both matrices are indexed by time.Weekday, starting on Sunday, and then by hour
+type Activity struct {
+	Days   int      `json:"days"`
+	Kills  [][]uint `json:"kills"`
+	Losses [][]uint `json:"losses"`
+}
+
+// NewActivity returns an empty activity matrix covering the given number of days
+func NewActivity(days int) *Activity {
+
+	activity := &Activity{
+		Days:   days,
+		Kills:  make([][]uint, 7),
+		Losses: make([][]uint, 7),
+	}
+
+	for i := range activity.Kills {
+		activity.Kills[i] = make([]uint, 24)
+		activity.Losses[i] = make([]uint, 24)
+	}
+
+	return activity
+
+}
+
+// ActivityCount is the number of killmails that happened in an hour of a day of the week
+type ActivityCount struct {
+	Weekday time.Weekday `bson:"weekday" json:"weekday"`
+	Hour    int          `bson:"hour" json:"hour"`
+	Count   uint         `bson:"count" json:"count"`
+}
@@ -20,19 +20,23 @@ import (
 	"github.com/eveisesi/neo/mdb"
 	"github.com/eveisesi/neo/services/alliance"
 	"github.com/eveisesi/neo/services/backup"
+	"github.com/eveisesi/neo/services/battle"
 	"github.com/eveisesi/neo/services/character"
 	"github.com/eveisesi/neo/services/corporation"
 	"github.com/eveisesi/neo/services/esi"
+	"github.com/eveisesi/neo/services/esikills"
 	"github.com/eveisesi/neo/services/history"
 	"github.com/eveisesi/neo/services/killmail"
 	"github.com/eveisesi/neo/services/market"
 	"github.com/eveisesi/neo/services/notifications"
+	"github.com/eveisesi/neo/services/queue"
 	"github.com/eveisesi/neo/services/search"
 	"github.com/eveisesi/neo/services/stats"
 	"github.com/eveisesi/neo/services/token"
 	"github.com/eveisesi/neo/services/top"
 	"github.com/eveisesi/neo/services/tracker"
 	"github.com/eveisesi/neo/services/universe"
+	"github.com/eveisesi/neo/services/watchlist"
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	"github.com/go-redis/redis/v8"
@@ -42,6 +46,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"golang.org/x/oauth2"
 )
 
 type App struct {
@@ -54,11 +59,14 @@ type App struct {
 	Config   *neo.Config
 	Spaces   *session.Session
 	ESI      esi.Service
+	Queue    queue.Service
 
 	Alliance     alliance.Service
 	Backup       backup.Service
+	Battle       battle.Service
 	Character    character.Service
 	Corporation  corporation.Service
+	ESIKills     esikills.Service
 	History      history.Service
 	Killmail     killmail.Service
 	Market       market.Service
@@ -69,6 +77,7 @@ type App struct {
 	Top          top.Service
 	Tracker      tracker.Service
 	Universe     universe.Service
+	Watchlist    watchlist.Service
 }
 
 func New(command string, debug bool) *App {
@@ -105,6 +114,11 @@ func New(command string, debug bool) *App {
 		logrus.WithError(err).Fatal("failed to make mongo db connection")
 	}
 
+	err = mdb.CreateIndexes(context.Background(), mongoDB)
+	if err != nil {
+		logger.WithError(err).Warn("failed to create mongo indexes")
+	}
+
 	redisClient := redis.NewClient(&redis.Options{
 		Addr:               cfg.RedisAddr,
 		MaxRetries:         5,
@@ -117,6 +131,19 @@ func New(command string, debug bool) *App {
 		logger.WithError(err).Fatal("failed to ping redis server")
 	}
 
+	consumer := cfg.QueueConsumerName
+	if consumer == "" {
+		consumer = fmt.Sprintf("%s-%s-%d", hostname, command, os.Getpid())
+	}
+
+	queue := queue.NewService(
+		redisClient,
+		logger,
+		neo.QUEUE_CONSUMER_GROUP,
+		consumer,
+		cfg.QueueVisibilityTimeout,
+	)
+
 	autocompleter := redisearch.NewAutocompleter(cfg.RedisAddr, "autocomplete")
 
 	client := &http.Client{
@@ -170,6 +197,7 @@ func New(command string, debug bool) *App {
 
 	top := top.NewService(
 		redisClient,
+		queue,
 	)
 
 	universe := universe.NewService(
@@ -191,26 +219,29 @@ func New(command string, debug bool) *App {
 		tracker,
 	)
 
-	// token := token.NewService(
-	// 	client,
-	// 	&oauth2.Config{
-	// 		ClientID:     cfg.SSOClientID,
-	// 		ClientSecret: cfg.SSOClientSecret,
-	// 		RedirectURL:  cfg.SSOCallback,
-	// 		Endpoint: oauth2.Endpoint{
-	// 			AuthURL:  cfg.SSOAuthorizationURL,
-	// 			TokenURL: cfg.SSOTokenURL,
-	// 		},
-	// 	},
-	// 	logger,
-	// 	redisClient,
-	// 	cfg.SSOJWKSURL,
-	// 	mysql.NewTokenRepository(mysqlDB),
-	// )
+	token := token.NewService(
+		client,
+		&oauth2.Config{
+			ClientID:     cfg.SSOClientID,
+			ClientSecret: cfg.SSOClientSecret,
+			RedirectURL:  cfg.SSOCallback,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  cfg.SSOAuthorizationURL,
+				TokenURL: cfg.SSOTokenURL,
+			},
+		},
+		logger,
+		redisClient,
+		cfg.SSOJWKSURL,
+		cfg.SessionSecret,
+		cfg.SessionTTL,
+		mdb.NewTokenRepository(mongoDB),
+	)
 
 	backup := backup.NewService(
 		redisClient,
 		logger,
+		mdb.NewKillmailRepository(mongoDB),
 	)
 
 	killmail := killmail.NewService(
@@ -227,23 +258,59 @@ func New(command string, debug bool) *App {
 		universe,
 		market,
 		tracker,
+		queue,
+		mdb.NewKillmailRepository(mongoDB),
+		mdb.NewNotificationRuleRepository(mongoDB),
+		mdb.NewNotificationDeliveryRepository(mongoDB),
+	)
+
+	battle := battle.NewService(
+		logger,
+		nr,
+		mdb.NewKillmailRepository(mongoDB),
+	)
+
+	esikills := esikills.NewService(
+		logger,
+		nr,
+		cfg,
+		esiClient,
+		tracker,
+		character,
+		killmail,
 		mdb.NewKillmailRepository(mongoDB),
+		token,
+	)
+
+	watchlist := watchlist.NewService(
+		logger,
+		token,
+		killmail,
+		mdb.NewWatchlistRepository(mongoDB),
 	)
 
 	history := history.NewService(
 		client,
-		redisClient,
+		queue,
 		logger,
 		nr,
 		cfg,
 		mdb.NewKillmailRepository(mongoDB),
 	)
 
-	// stats := stats.NewService(redisClient, logger, nr, killmail, mysql.NewStatRepository(mysqlDB))
+	stats := stats.NewService(
+		redisClient,
+		queue,
+		logger,
+		nr,
+		mdb.NewKillmailRepository(mongoDB),
+		mdb.NewStatsRepository(mongoDB),
+	)
 
 	notifications := notifications.NewService(
 		client,
 		redisClient,
+		queue,
 		logger,
 		nr,
 		cfg,
@@ -252,6 +319,9 @@ func New(command string, debug bool) *App {
 		alliance,
 		universe,
 		killmail,
+		search,
+		mdb.NewNotificationRuleRepository(mongoDB),
+		mdb.NewNotificationDeliveryRepository(mongoDB),
 	)
 
 	return &App{
@@ -262,22 +332,26 @@ func New(command string, debug bool) *App {
 		Redis:    redisClient,
 		Client:   client,
 		ESI:      esiClient,
+		Queue:    queue,
 		Config:   cfg,
 
 		Alliance:     alliance,
 		Backup:       backup,
+		Battle:       battle,
 		Character:    character,
 		Corporation:  corporation,
+		ESIKills:     esikills,
 		History:      history,
 		Killmail:     killmail,
 		Market:       market,
 		Notification: notifications,
 		Search:       search,
-		// Stats:    stats,
-		// Token:    token,
-		Top:      top,
-		Tracker:  tracker,
-		Universe: universe,
+		Stats:        stats,
+		Token:        token,
+		Top:          top,
+		Tracker:      tracker,
+		Universe:     universe,
+		Watchlist:    watchlist,
 	}
 
 }
@@ -0,0 +1,75 @@
+package neo
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// BattleReport is a cluster of killmails that occurred in the same location within a sliding time window.
+// Battle reports are not stored, they are detected on demand from the killmails collection
+type BattleReport struct {
+	ID         string        `json:"id"`
+	Scope      BattleScope   `json:"scope"`
+	LocationID uint          `json:"locationID"`
+	StartTime  time.Time     `json:"startTime"`
+	EndTime    time.Time     `json:"endTime"`
+	TotalValue float64       `json:"totalValue"`
+	Killmails  []*Killmail   `json:"killmails"`
+	Sides      []*BattleSide `json:"sides"`
+}
+
+// BattleSide is a group of alliances and corporations that fought together during a battle
+type BattleSide struct {
+	AllianceIDs    []uint   `json:"allianceIDs"`
+	CorporationIDs []uint   `json:"corporationIDs"`
+	CharacterIDs   []uint64 `json:"characterIDs"`
+	ISKKilled      float64  `json:"iskKilled"`
+	ISKLost        float64  `json:"iskLost"`
+	ShipsKilled    uint     `json:"shipsKilled"`
+	ShipsLost      uint     `json:"shipsLost"`
+	Efficiency     float64  `json:"efficiency"`
+}
+
+// BattleScope determines whether killmails are clustered by solar system or by constellation
+type BattleScope string
+
+const (
+	BattleScopeSystem        BattleScope = "system"
+	BattleScopeConstellation BattleScope = "constellation"
+)
+
+var AllBattleScopes = []BattleScope{
+	BattleScopeSystem,
+	BattleScopeConstellation,
+}
+
+func (e BattleScope) IsValid() bool {
+	switch e {
+	case BattleScopeSystem, BattleScopeConstellation:
+		return true
+	}
+	return false
+}
+
+func (e BattleScope) String() string {
+	return string(e)
+}
+
+func (e *BattleScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BattleScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BattleScope", str)
+	}
+	return nil
+}
+
+func (e BattleScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eveisesi/neo"
+	core "github.com/eveisesi/neo/app"
+	"github.com/jedib0t/go-pretty/table"
+	"github.com/urfave/cli"
+)
+
+func backupCommand() cli.Command {
+	return cli.Command{
+		Name:  "backup",
+		Usage: "Parent command for managing the raw killmail backup archive",
+		Subcommands: []cli.Command{
+			cli.Command{
+				Name:  "archive",
+				Usage: "Rolls the staged and legacy raw backups for each day into a compressed archive with a manifest",
+				Action: func(c *cli.Context) error {
+					from, to, err := backupDateRange(c)
+					if err != nil {
+						return err
+					}
+
+					app := core.New("backup-archive", false)
+
+					for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+						_, err := app.Backup.Archive(context.Background(), c.String("dir"), date)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+					}
+
+					return nil
+				},
+				Flags: backupFlags(),
+			},
+			cli.Command{
+				Name:  "verify",
+				Usage: "Reports days whose archive is damaged or whose killmail count differs from the stored kill hashes",
+				Action: func(c *cli.Context) error {
+					from, to, err := backupDateRange(c)
+					if err != nil {
+						return err
+					}
+
+					app := core.New("backup-verify", false)
+
+					verifications, err := app.Backup.Verify(context.Background(), c.String("dir"), from, to)
+					if err != nil {
+						return cli.NewExitError(err, 1)
+					}
+
+					tw := table.NewWriter()
+					tw.AppendHeader(table.Row{"Date", "Archived", "Expected", "Problems"})
+					failed := 0
+					for _, verification := range verifications {
+						if verification.OK() {
+							continue
+						}
+						failed++
+						tw.AppendRow(table.Row{
+							verification.Date.Format("2006-01-02"),
+							verification.Archived,
+							verification.Expected,
+							strings.Join(verification.Problems, "\n"),
+						})
+					}
+					tw.AppendFooter(table.Row{"", "", "Failed", fmt.Sprintf("%d of %d", failed, len(verifications))})
+
+					fmt.Println(tw.Render())
+
+					if failed > 0 {
+						return cli.NewExitError(fmt.Sprintf("%d days failed verification", failed), 1)
+					}
+
+					return nil
+				},
+				Flags: backupFlags(),
+			},
+		},
+	}
+}
+
+func backupFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "dir",
+			Usage: "Directory containing the raw killmail backups",
+			Value: neo.BACKUP_KILLMAIL_RAW_DIRECTORY,
+		},
+		cli.StringFlag{
+			Name:     "from",
+			Usage:    "First date to process. (Format: YYYYMMDD)",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  "to",
+			Usage: "Last date to process, inclusive. Defaults to from. (Format: YYYYMMDD)",
+		},
+	}
+}
+
+func backupDateRange(c *cli.Context) (time.Time, time.Time, error) {
+
+	from, err := time.Parse("20060102", c.String("from"))
+	if err != nil {
+		return from, from, cli.NewExitError(fmt.Sprintf("invalid from date: %s", err), 1)
+	}
+
+	if c.String("to") == "" {
+		return from, from, nil
+	}
+
+	to, err := time.Parse("20060102", c.String("to"))
+	if err != nil {
+		return from, to, cli.NewExitError(fmt.Sprintf("invalid to date: %s", err), 1)
+	}
+
+	if to.Before(from) {
+		return from, to, cli.NewExitError("to must not be before from", 1)
+	}
+
+	return from, to, nil
+
+}
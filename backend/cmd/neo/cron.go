@@ -46,6 +46,16 @@ func cronCommand() cli.Command {
 			if err != nil {
 				app.Logger.WithError(err).Fatal("failed to initialize trackingJanitorCron")
 			}
+
+			_, err = c.AddFunc("0 30 0 * * *", backupArchiveCron)
+			if err != nil {
+				app.Logger.WithError(err).Fatal("failed to initialize backupArchiveCron")
+			}
+
+			_, err = c.AddFunc("0 5 * * * *", leaderboardCron)
+			if err != nil {
+				app.Logger.WithError(err).Fatal("failed to initialize leaderboardCron")
+			}
 			app.Logger.Info("crons registered, starting go cron")
 			c.Run()
 
@@ -79,6 +89,24 @@ func cronCommand() cli.Command {
 					return nil
 				},
 			},
+			cli.Command{
+				// Runs every day at 00:30
+				Name:  "backup",
+				Usage: "Rolls every staged day of raw killmail backups before today into a compressed archive",
+				Action: func(c *cli.Context) error {
+					backupArchiveCron()
+					return nil
+				},
+			},
+			cli.Command{
+				// Runs every hour at five past
+				Name:  "leaderboards",
+				Usage: "Precomputes the global kill leaderboards",
+				Action: func(c *cli.Context) error {
+					leaderboardCron()
+					return nil
+				},
+			},
 			cli.Command{
 				// Runs every minute
 				Name:  "janitor",
@@ -184,3 +212,55 @@ func trackingJanitorCron() {
 	app.Redis.Close()
 	app.NewRelic.Shutdown(time.Minute)
 }
+
+func backupArchiveCron() {
+	app := core.New("cron-backup-archive", false)
+	txn := app.NewRelic.StartTransaction("cron-backup-archive")
+	ctx := newrelic.NewContext(context.Background(), txn)
+	app.Logger.WithContext(ctx).Info("starting backup archive")
+
+	now := time.Now().In(time.UTC)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Late kills, backfills and ESI imports stage killmails under older days, so every staged day is archived
+	// rather than just yesterday. Today is left alone until it is over
+	dates, err := app.Backup.StagedDates(ctx, neo.BACKUP_KILLMAIL_RAW_DIRECTORY)
+	if err != nil {
+		app.Logger.WithContext(ctx).WithError(err).Error("failed to list staged backups")
+	}
+
+	for _, date := range dates {
+		if !date.Before(today) {
+			continue
+		}
+
+		_, err = app.Backup.Archive(ctx, neo.BACKUP_KILLMAIL_RAW_DIRECTORY, date)
+		if err != nil {
+			app.Logger.WithContext(ctx).WithError(err).WithField("date", date.Format("2006-01-02")).Error("failed to archive backups")
+		}
+	}
+
+	app.Logger.WithContext(ctx).Info("done with backup archive")
+	txn.End()
+	app.MongoDB.Client().Disconnect(ctx)
+	app.Redis.Close()
+	app.NewRelic.Shutdown(time.Minute)
+}
+
+func leaderboardCron() {
+	app := core.New("cron-leaderboards", false)
+	txn := app.NewRelic.StartTransaction("cron-leaderboards")
+	ctx := newrelic.NewContext(context.Background(), txn)
+	app.Logger.WithContext(ctx).Info("starting leaderboard build")
+
+	built, err := app.Stats.BuildLeaderboards(ctx)
+	if err != nil {
+		app.Logger.WithContext(ctx).WithError(err).Error("failed to build leaderboards")
+	}
+
+	app.Logger.WithContext(ctx).WithField("built", built).Info("done building leaderboards")
+	txn.End()
+	app.MongoDB.Client().Disconnect(ctx)
+	app.Redis.Close()
+	app.NewRelic.Shutdown(time.Minute)
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	core "github.com/eveisesi/neo/app"
+	"github.com/jedib0t/go-pretty/table"
+	"github.com/urfave/cli"
+)
+
+func dlqCommands() []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "list",
+			Usage: "Lists the messages currently sitting in the killmail dead letter queue",
+			Action: func(c *cli.Context) error {
+				app := core.New("killmail-dlq-list", false)
+
+				messages, count, err := app.Killmail.DeadLetters(context.Background(), c.Int64("limit"))
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				tw := table.NewWriter()
+				tw.AppendHeader(table.Row{"ID", "Hash", "Attempts", "Last Attempt", "Last Error"})
+				for _, message := range messages {
+					tw.AppendRow(table.Row{
+						message.ID,
+						message.Hash,
+						message.Attempts,
+						time.Unix(message.LastAttempt, 0).UTC().Format("2006-01-02 15:04:05"),
+						message.LastError,
+					})
+				}
+				tw.AppendFooter(table.Row{"", "", "", "Total", count})
+
+				fmt.Println(tw.Render())
+
+				return nil
+			},
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "limit",
+					Usage: "Maximum number of messages to list",
+					Value: 50,
+				},
+			},
+		},
+		cli.Command{
+			Name:  "inspect",
+			Usage: "Prints every dead letter recorded for a killmail",
+			Action: func(c *cli.Context) error {
+				app := core.New("killmail-dlq-inspect", false)
+
+				id := c.Uint("id")
+				messages, err := app.Killmail.DeadLetter(context.Background(), id)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				if len(messages) == 0 {
+					return cli.NewExitError(fmt.Sprintf("killmail %d is not in the dead letter queue", id), 1)
+				}
+
+				for _, message := range messages {
+					tw := table.NewWriter()
+					tw.AppendRows([]table.Row{
+						{"ID", message.ID},
+						{"Hash", message.Hash},
+						{"Attempts", message.Attempts},
+						{"Last Attempt", time.Unix(message.LastAttempt, 0).UTC().Format("2006-01-02 15:04:05")},
+						{"Last Error", message.LastError},
+					})
+					fmt.Println(tw.Render())
+				}
+
+				return nil
+			},
+			Flags: []cli.Flag{
+				cli.UintFlag{
+					Name:     "id",
+					Usage:    "ID of the killmail to inspect",
+					Required: true,
+				},
+			},
+		},
+		cli.Command{
+			Name:  "replay",
+			Usage: "Moves messages from the dead letter queue back onto the processing queue with a fresh retry budget",
+			Action: func(c *cli.Context) error {
+				ids, err := dlqIDs(c)
+				if err != nil {
+					return err
+				}
+
+				app := core.New("killmail-dlq-replay", false)
+
+				replayed, err := app.Killmail.ReplayDeadLetters(context.Background(), ids...)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				app.Logger.WithField("replayed", replayed).Info("dead letters replayed")
+
+				return nil
+			},
+			Flags: dlqSelectionFlags(),
+		},
+		cli.Command{
+			Name:  "purge",
+			Usage: "Removes messages from the dead letter queue without processing them",
+			Action: func(c *cli.Context) error {
+				ids, err := dlqIDs(c)
+				if err != nil {
+					return err
+				}
+
+				app := core.New("killmail-dlq-purge", false)
+
+				purged, err := app.Killmail.PurgeDeadLetters(context.Background(), ids...)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				app.Logger.WithField("purged", purged).Info("dead letters purged")
+
+				return nil
+			},
+			Flags: dlqSelectionFlags(),
+		},
+	}
+}
+
+func dlqSelectionFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.IntSliceFlag{
+			Name:  "id",
+			Usage: "ID of a killmail in the dead letter queue. May be provided multiple times",
+		},
+		cli.BoolFlag{
+			Name:  "all",
+			Usage: "Apply to every message in the dead letter queue",
+		},
+	}
+}
+
+// dlqIDs returns the ids selected on the command line. An empty slice means every message
+// in the queue and is only returned when --all has been explicitly set
+func dlqIDs(c *cli.Context) ([]uint, error) {
+
+	raw := c.IntSlice("id")
+	all := c.Bool("all")
+
+	if len(raw) == 0 && !all {
+		return nil, cli.NewExitError("one of --id or --all is required", 1)
+	}
+
+	if len(raw) > 0 && all {
+		return nil, cli.NewExitError("--id and --all are mutually exclusive", 1)
+	}
+
+	ids := make([]uint, 0, len(raw))
+	for _, id := range raw {
+		if id <= 0 {
+			return nil, cli.NewExitError(fmt.Sprintf("invalid killmail id %d", id), 1)
+		}
+		ids = append(ids, uint(id))
+	}
+
+	return ids, nil
+
+}
@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/eveisesi/neo"
 	core "github.com/eveisesi/neo/app"
+	killmailsvc "github.com/eveisesi/neo/services/killmail"
+	"github.com/jedib0t/go-pretty/table"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
@@ -49,6 +54,10 @@ func killmail() cli.Command {
 				Action: func(c *cli.Context) error {
 
 					in := c.String("in")
+					lane := neo.Lane(c.String("lane"))
+					if !lane.IsValid() {
+						return cli.NewExitError(fmt.Sprintf("invalid lane %s, must be one of %v", lane, neo.AllLanes), 1)
+					}
 					// delete := c.Bool("delete")
 
 					app := core.New("killmail-add", false)
@@ -71,7 +80,7 @@ func killmail() cli.Command {
 					// 	}
 					// }
 
-					app.Killmail.DispatchPayload(&neo.Message{ID: uint(id), Hash: hash})
+					app.Killmail.DispatchPayload(lane, &neo.Message{ID: uint(id), Hash: hash})
 
 					return nil
 
@@ -82,6 +91,11 @@ func killmail() cli.Command {
 						Usage:    "id:hash",
 						Required: true,
 					},
+					cli.StringFlag{
+						Name:  "lane",
+						Usage: "Processing lane to dispatch the killmail onto. One of live, manual or backfill",
+						Value: neo.LaneManual.String(),
+					},
 					// cli.BoolFlag{
 					// 	Name:  "delete",
 					// 	Usage: "delete this killmail before dispatching",
@@ -134,7 +148,211 @@ func killmail() cli.Command {
 					},
 				},
 			},
+			cli.Command{
+				Name:  "restore",
+				Usage: "Re-imports killmails from the raw backup archive, running them through enrichment and valuation without calling ESI",
+				Action: func(c *cli.Context) error {
+					from, err := time.Parse("20060102", c.String("from"))
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("invalid from date: %s", err), 1)
+					}
+
+					to, err := time.Parse("20060102", c.String("to"))
+					if err != nil {
+						return cli.NewExitError(fmt.Sprintf("invalid to date: %s", err), 1)
+					}
+
+					if to.Before(from) {
+						return cli.NewExitError("to must not be before from", 1)
+					}
+
+					app := core.New("killmail-restore", false)
+
+					restored, err := app.Killmail.Restore(context.Background(), c.String("dir"), from, to, c.Int64("gLimit"))
+					if err != nil {
+						return cli.NewExitError(err, 1)
+					}
+
+					app.Logger.WithField("restored", restored).Info("killmails restored")
+
+					return nil
+				},
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "dir",
+						Usage: "Directory containing the dated raw backup directories",
+						Value: neo.BACKUP_KILLMAIL_RAW_DIRECTORY,
+					},
+					cli.StringFlag{
+						Name:     "from",
+						Usage:    "First date to restore. (Format: YYYYMMDD)",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:     "to",
+						Usage:    "Last date to restore, inclusive. (Format: YYYYMMDD)",
+						Required: true,
+					},
+					cli.Int64Flag{
+						Name:  "gLimit",
+						Usage: "gLimit is the number of goroutines that the limiter should allow to be in flight at any one time",
+						Value: 10,
+					},
+				},
+			},
+			cli.Command{
+				Name:  "recalculate",
+				Usage: "Re-runs valuation against stored killmails and persists any values that changed",
+				Action: func(c *cli.Context) error {
+					filter, err := backfillFilter(c)
+					if err != nil {
+						return err
+					}
+
+					dryRun := c.Bool("dry-run")
+
+					app := core.New("killmail-recalculate", false)
+
+					tw := table.NewWriter()
+					tw.AppendHeader(table.Row{"ID", "Killmail Time", "Old Total", "New Total", "Difference", "Old Dropped", "New Dropped", "Old Fitted", "New Fitted"})
+
+					changed, err := app.Killmail.Recalculate(context.Background(), filter, dryRun, c.Int64("gLimit"), func(r *killmailsvc.Revaluation) {
+						if !dryRun {
+							return
+						}
+						tw.AppendRow(table.Row{
+							r.ID,
+							r.KillmailTime.Format("2006-01-02 15:04:05"),
+							fmt.Sprintf("%.2f", r.Old.Total),
+							fmt.Sprintf("%.2f", r.New.Total),
+							fmt.Sprintf("%.2f", r.New.Total-r.Old.Total),
+							fmt.Sprintf("%.2f", r.Old.Dropped),
+							fmt.Sprintf("%.2f", r.New.Dropped),
+							fmt.Sprintf("%.2f", r.Old.Fitted),
+							fmt.Sprintf("%.2f", r.New.Fitted),
+						})
+					})
+					if err != nil {
+						return cli.NewExitError(err, 1)
+					}
+
+					if dryRun {
+						tw.SortBy([]table.SortBy{{Name: "ID", Mode: table.AscNumeric}})
+						tw.AppendFooter(table.Row{"", "", "", "", "", "", "", "Changed", changed})
+						fmt.Println(tw.Render())
+						return nil
+					}
+
+					app.Logger.WithField("changed", changed).Info("killmails recalculated")
+
+					return nil
+				},
+				Flags: append(
+					backfillFlags(),
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "Print a report of old versus new values without persisting anything",
+					},
+				),
+			},
+			cli.Command{
+				Name:  "classify",
+				Usage: "Re-runs the classifier rules against stored killmails to backfill their tags",
+				Action: func(c *cli.Context) error {
+					filter, err := backfillFilter(c)
+					if err != nil {
+						return err
+					}
+
+					app := core.New("killmail-classify", false)
+
+					changed, err := app.Killmail.Reclassify(context.Background(), filter, c.Int64("gLimit"))
+					if err != nil {
+						return cli.NewExitError(err, 1)
+					}
+
+					app.Logger.WithField("changed", changed).Info("killmails reclassified")
+
+					return nil
+				},
+				Flags: backfillFlags(),
+			},
+			cli.Command{
+				Name:        "dlq",
+				Usage:       "Inspect and re-drive killmails that exhausted their retry budget in the importer",
+				Subcommands: dlqCommands(),
+			},
+		},
+	}
+
+}
+
+func backfillFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "from",
+			Usage: "Only include killmails that occurred on or after this date. (Format: YYYYMMDD)",
+		},
+		cli.StringFlag{
+			Name:  "to",
+			Usage: "Only include killmails that occurred on or before this date. (Format: YYYYMMDD)",
+		},
+		cli.IntSliceFlag{
+			Name:  "typeID",
+			Usage: "Only include killmails where the victim ship or one of its items is of this type. May be provided multiple times",
+		},
+		cli.Uint64Flag{
+			Name:  "characterID",
+			Usage: "Only include killmails this character is involved in",
+		},
+		cli.UintFlag{
+			Name:  "corporationID",
+			Usage: "Only include killmails this corporation is involved in",
+		},
+		cli.UintFlag{
+			Name:  "allianceID",
+			Usage: "Only include killmails this alliance is involved in",
+		},
+		cli.Int64Flag{
+			Name:  "gLimit",
+			Usage: "gLimit is the number of goroutines that the limiter should allow to be in flight at any one time",
+			Value: 10,
 		},
 	}
+}
+
+func backfillFilter(c *cli.Context) (killmailsvc.BackfillFilter, error) {
+
+	filter := killmailsvc.BackfillFilter{
+		CharacterID:   c.Uint64("characterID"),
+		CorporationID: c.Uint("corporationID"),
+		AllianceID:    c.Uint("allianceID"),
+	}
+
+	if c.String("from") != "" {
+		from, err := time.Parse("20060102", c.String("from"))
+		if err != nil {
+			return filter, cli.NewExitError(fmt.Sprintf("invalid from date: %s", err), 1)
+		}
+		filter.From = from
+	}
+
+	if c.String("to") != "" {
+		to, err := time.Parse("20060102", c.String("to"))
+		if err != nil {
+			return filter, cli.NewExitError(fmt.Sprintf("invalid to date: %s", err), 1)
+		}
+		// to is inclusive of the whole day
+		filter.To = to.AddDate(0, 0, 1)
+	}
+
+	for _, id := range c.IntSlice("typeID") {
+		if id <= 0 {
+			return filter, cli.NewExitError(fmt.Sprintf("invalid type id %d", id), 1)
+		}
+		filter.TypeIDs = append(filter.TypeIDs, uint(id))
+	}
+
+	return filter, nil
 
 }
@@ -41,10 +41,12 @@ func init() {
 			},
 		},
 		killmail(),
+		backupCommand(),
 		alliances(),
 		characters(),
 		corporations(),
 		cronCommand(),
+		statsCommand(),
 		test(),
 		cli.Command{
 			Name:   "serve",
@@ -101,17 +103,66 @@ func init() {
 		},
 		cli.Command{
 			Name:        "notifications",
-			Description: "Notifications subscribe to the a Redis PubSub. When the importer detects a killmail with a value greater than the configured notification value, it publishes the id and hash to this pubsub and this service will format the message for slack and post the killmail to slack",
+			Description: "Consumes the notification queue. The importer matches every killmail against the enabled notification rules and queues a delivery for each destination with a matching rule, which this command formats and posts to the destination",
 			Action: func(c *cli.Context) error {
 				app := core.New("notifier", false)
 
-				if !app.Config.SlackNotifierEnabled {
+				app.Notification.Run(context.Background())
+				return nil
+			},
+			Subcommands: notificationCommands(),
+		},
+		cli.Command{
+			Name:        "esikills",
+			Description: "Walks the recent killmails of every character and corporation that has granted us an SSO token and dispatches the killmails we have not seen to the importer",
+			Action: func(c *cli.Context) error {
+				app := core.New("esikills", c.GlobalBool("debug"))
+
+				if !c.Bool("once") {
+					app.ESIKills.Run(context.Background())
 					return nil
 				}
 
-				app.Notification.Run(context.Background())
+				dispatched, err := app.ESIKills.Import(context.Background())
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				app.Logger.WithField("dispatched", dispatched).Info("esi killmail import complete")
 				return nil
 			},
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "once",
+					Usage: "Make a single pass over the tokens and exit",
+				},
+			},
+		},
+		cli.Command{
+			Name:        "tokens",
+			Description: "Refreshes stored SSO access tokens before they expire and disables tokens that SSO has revoked",
+			Action: func(c *cli.Context) error {
+				app := core.New("tokens", c.GlobalBool("debug"))
+
+				if !c.Bool("once") {
+					app.Token.Run(context.Background())
+					return nil
+				}
+
+				refreshed, err := app.Token.RefreshExpiring(context.Background())
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				app.Logger.WithField("refreshed", refreshed).Info("token refresh complete")
+				return nil
+			},
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "once",
+					Usage: "Refresh the tokens that are about to expire once and exit",
+				},
+			},
 		},
 		cli.Command{
 			Name:        "updater",
@@ -0,0 +1,333 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/eveisesi/neo"
+	core "github.com/eveisesi/neo/app"
+	"github.com/jedib0t/go-pretty/table"
+	"github.com/urfave/cli"
+)
+
+func notificationCommands() []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:        "rules",
+			Usage:       "Manages the rules that decide which killmails are sent to which destination",
+			Subcommands: notificationRuleCommands(),
+		},
+		cli.Command{
+			Name:  "log",
+			Usage: "Lists the most recent notification deliveries and their outcome",
+			Action: func(c *cli.Context) error {
+				status := neo.NotificationDeliveryStatus(c.String("status"))
+
+				app := core.New("notifications-log", false)
+
+				deliveries, err := app.Notification.Deliveries(context.Background(), c.Int64("limit"), status)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				tw := table.NewWriter()
+				tw.AppendHeader(table.Row{"Created", "Killmail", "Destination", "Status", "Attempts", "Response", "Next Attempt", "Last Error"})
+				for _, delivery := range deliveries {
+					next := ""
+					if delivery.NextAttemptAt.Valid {
+						next = delivery.NextAttemptAt.Time.UTC().Format("2006-01-02 15:04:05")
+					}
+
+					tw.AppendRow(table.Row{
+						delivery.CreatedAt.UTC().Format("2006-01-02 15:04:05"),
+						delivery.KillmailID,
+						delivery.Destination.Type,
+						delivery.Status,
+						delivery.Attempts,
+						delivery.ResponseCode,
+						next,
+						delivery.LastError,
+					})
+				}
+
+				fmt.Println(tw.Render())
+
+				return nil
+			},
+			Flags: []cli.Flag{
+				cli.Int64Flag{
+					Name:  "limit",
+					Usage: "Maximum number of deliveries to list",
+					Value: 50,
+				},
+				cli.StringFlag{
+					Name:  "status",
+					Usage: "Only list deliveries with this status (pending, retrying, delivered, failed)",
+				},
+			},
+		},
+	}
+}
+
+func notificationRuleCommands() []cli.Command {
+	return []cli.Command{
+		cli.Command{
+			Name:  "list",
+			Usage: "Lists every notification rule",
+			Action: func(c *cli.Context) error {
+				app := core.New("notifications-rules-list", false)
+
+				rules, err := app.Notification.Rules(context.Background())
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				tw := table.NewWriter()
+				tw.AppendHeader(table.Row{"ID", "Name", "Enabled", "Destination", "Criteria"})
+				for _, rule := range rules {
+					criteria, _ := json.Marshal(rule.Criteria)
+					tw.AppendRow(table.Row{
+						rule.ID,
+						rule.Name,
+						rule.Enabled,
+						rule.Destination.Type,
+						string(criteria),
+					})
+				}
+
+				fmt.Println(tw.Render())
+
+				return nil
+			},
+		},
+		cli.Command{
+			Name:  "add",
+			Usage: "Creates a notification rule. Killmails must match every criteria that is provided",
+			Action: func(c *cli.Context) error {
+				rule, err := notificationRuleFromFlags(c)
+				if err != nil {
+					return err
+				}
+
+				app := core.New("notifications-rules-add", false)
+
+				rule, err = app.Notification.CreateRule(context.Background(), rule)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				app.Logger.WithField("id", rule.ID).Info("notification rule created")
+
+				return nil
+			},
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "name",
+					Usage:    "Name of the rule",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "type",
+					Usage: "Type of the destination (slack, discord, webhook)",
+					Value: neo.NotificationDestinationSlack.String(),
+				},
+				cli.StringFlag{
+					Name:     "url",
+					Usage:    "Webhook URL of the destination",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "secret",
+					Usage: "Secret the payloads sent to webhook destinations are signed with. Required for webhook destinations",
+				},
+				cli.IntSliceFlag{
+					Name:  "character",
+					Usage: "ID of a character to match. May be provided multiple times",
+				},
+				cli.IntSliceFlag{
+					Name:  "corporation",
+					Usage: "ID of a corporation to match. May be provided multiple times",
+				},
+				cli.IntSliceFlag{
+					Name:  "alliance",
+					Usage: "ID of an alliance to match. May be provided multiple times",
+				},
+				cli.StringFlag{
+					Name:  "side",
+					Usage: "Only match the character, corporation and alliance ids on this side of the killmail (attacker, victim)",
+				},
+				cli.IntSliceFlag{
+					Name:  "region",
+					Usage: "ID of a region to match. May be provided multiple times",
+				},
+				cli.IntSliceFlag{
+					Name:  "system",
+					Usage: "ID of a solar system to match. May be provided multiple times",
+				},
+				cli.IntSliceFlag{
+					Name:  "ship-group",
+					Usage: "ID of a group the victim's ship has to belong to. May be provided multiple times",
+				},
+				cli.IntSliceFlag{
+					Name:  "ship-type",
+					Usage: "ID of a type the victim's ship has to be. May be provided multiple times",
+				},
+				cli.Float64Flag{
+					Name:  "min-value",
+					Usage: "Minimum total value of the killmail in ISK",
+				},
+				cli.Float64Flag{
+					Name:  "max-value",
+					Usage: "Maximum total value of the killmail in ISK",
+				},
+				cli.StringFlag{
+					Name:  "solo",
+					Usage: "Only match solo killmails when true, or exclude them when false",
+				},
+				cli.StringFlag{
+					Name:  "npc",
+					Usage: "Only match NPC killmails when true, or exclude them when false",
+				},
+				cli.StringFlag{
+					Name:  "awox",
+					Usage: "Only match awox killmails when true, or exclude them when false",
+				},
+				cli.BoolFlag{
+					Name:  "disabled",
+					Usage: "Create the rule without enabling it",
+				},
+			},
+		},
+		cli.Command{
+			Name:  "enable",
+			Usage: "Enables a notification rule",
+			Action: func(c *cli.Context) error {
+				return setNotificationRuleEnabled(c, true)
+			},
+			Flags: notificationRuleIDFlags(),
+		},
+		cli.Command{
+			Name:  "disable",
+			Usage: "Disables a notification rule without removing it",
+			Action: func(c *cli.Context) error {
+				return setNotificationRuleEnabled(c, false)
+			},
+			Flags: notificationRuleIDFlags(),
+		},
+		cli.Command{
+			Name:  "remove",
+			Usage: "Removes a notification rule",
+			Action: func(c *cli.Context) error {
+				app := core.New("notifications-rules-remove", false)
+
+				id := c.String("id")
+				removed, err := app.Notification.DeleteRule(context.Background(), id)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+
+				if !removed {
+					return cli.NewExitError(fmt.Sprintf("notification rule %s does not exist", id), 1)
+				}
+
+				app.Logger.WithField("id", id).Info("notification rule removed")
+
+				return nil
+			},
+			Flags: notificationRuleIDFlags(),
+		},
+	}
+}
+
+func notificationRuleIDFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:     "id",
+			Usage:    "ID of the notification rule",
+			Required: true,
+		},
+	}
+}
+
+func setNotificationRuleEnabled(c *cli.Context, enabled bool) error {
+
+	app := core.New("notifications-rules-toggle", false)
+
+	rule, err := app.Notification.SetRuleEnabled(context.Background(), c.String("id"), enabled)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	app.Logger.WithField("id", rule.ID).WithField("enabled", rule.Enabled).Info("notification rule updated")
+
+	return nil
+
+}
+
+func notificationRuleFromFlags(c *cli.Context) (*neo.NotificationRule, error) {
+
+	rule := &neo.NotificationRule{
+		Name:    c.String("name"),
+		Enabled: !c.Bool("disabled"),
+		Destination: neo.NotificationDestination{
+			Type:   neo.NotificationDestinationType(c.String("type")),
+			URL:    c.String("url"),
+			Secret: c.String("secret"),
+		},
+		Criteria: neo.KillmailCriteria{
+			CorporationIDs: flagUints(c, "corporation"),
+			AllianceIDs:    flagUints(c, "alliance"),
+			Side:           neo.KillmailSide(c.String("side")),
+			RegionIDs:      flagUints(c, "region"),
+			SolarSystemIDs: flagUints(c, "system"),
+			ShipGroupIDs:   flagUints(c, "ship-group"),
+			ShipTypeIDs:    flagUints(c, "ship-type"),
+			MinTotalValue:  c.Float64("min-value"),
+			MaxTotalValue:  c.Float64("max-value"),
+		},
+	}
+
+	for _, id := range c.IntSlice("character") {
+		rule.Criteria.CharacterIDs = append(rule.Criteria.CharacterIDs, uint64(id))
+	}
+
+	flags := map[string]**bool{
+		"solo": &rule.Criteria.IsSolo,
+		"npc":  &rule.Criteria.IsNPC,
+		"awox": &rule.Criteria.IsAwox,
+	}
+
+	for name, target := range flags {
+		if !c.IsSet(name) {
+			continue
+		}
+
+		value, err := strconv.ParseBool(c.String(name))
+		if err != nil {
+			return nil, cli.NewExitError(fmt.Sprintf("--%s must be true or false", name), 1)
+		}
+
+		*target = &value
+	}
+
+	return rule, nil
+
+}
+
+func flagUints(c *cli.Context, name string) []uint {
+
+	raw := c.IntSlice(name)
+	if len(raw) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(raw))
+	for _, id := range raw {
+		ids = append(ids, uint(id))
+	}
+
+	return ids
+
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eveisesi/neo"
+	core "github.com/eveisesi/neo/app"
+	"github.com/urfave/cli"
+)
+
+func statsCommand() cli.Command {
+	return cli.Command{
+		Name:        "stats",
+		Description: "Consumes the stats queue. The importer queues every killmail it imports, which this command adds to the daily, monthly, yearly and all-time counters of every entity involved",
+		Action: func(c *cli.Context) error {
+			app := core.New("stats", c.GlobalBool("debug"))
+
+			app.Stats.Run(context.Background())
+			return nil
+		},
+		Subcommands: []cli.Command{
+			cli.Command{
+				Name:  "rebuild",
+				Usage: "Recomputes the counters of an entity type from the stored killmails. Stop the stats consumer while a rebuild runs",
+				Action: func(c *cli.Context) error {
+					entity := neo.StatEntity(c.String("entity"))
+					if !entity.IsValid() {
+						return cli.NewExitError(fmt.Sprintf("invalid entity %q, expected one of %v", entity, neo.AllCategories), 1)
+					}
+
+					var from time.Time
+					if c.String("from") != "" {
+						parsed, err := time.Parse("20060102", c.String("from"))
+						if err != nil {
+							return cli.NewExitError(fmt.Sprintf("invalid from date: %s", err), 1)
+						}
+						from = parsed
+					}
+
+					app := core.New("stats-rebuild", c.GlobalBool("debug"))
+
+					counted, err := app.Stats.Rebuild(context.Background(), entity, from)
+					if err != nil {
+						return cli.NewExitError(err, 1)
+					}
+
+					app.Logger.WithField("entity", entity).WithField("counted", counted).Info("stats rebuild complete")
+
+					return nil
+				},
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "entity",
+						Usage:    "Entity type to rebuild the counters of",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:  "from",
+						Usage: "Rebuild the counters from the start of the year this date falls in. Defaults to rebuilding every counter. (Format: YYYYMMDD)",
+					},
+				},
+			},
+		},
+	}
+}
@@ -1,5 +1,7 @@
 package neo
 
+import "time"
+
 type Config struct {
 	// db configuration
 	Mongo struct {
@@ -34,13 +36,41 @@ type Config struct {
 	SSOTokenURL         string `envconfig:"SSO_TOKEN_URL" required:"true"`
 	SSOJWKSURL          string `envconfig:"SSO_JWKS_URL" required:"true"`
 
-	SlackNotifierEnabled        bool   `envconfig:"SLACK_NOTIFIER_ENABLED" default:"false"`
-	SlackNotifierWebhookURL     string `envconfig:"SLACK_NOTIFIER_URL"`
-	SlackNotifierValueThreshold int    `envconfig:"SLACK_NOTIFIER_THRESHOLD"`
-	SlackActionBaseURL          string `envconfig:"SLACK_ACTION_BASE_URL"`
+	// Secret used to sign the session tokens the API issues after SSO and how long those sessions last
+	SessionSecret string        `envconfig:"SESSION_SECRET" required:"true"`
+	SessionTTL    time.Duration `envconfig:"SESSION_TTL" default:"168h"`
+
+	// Killmails older than this when they are imported are not matched against notification rules
+	NotificationMaxAge time.Duration `envconfig:"NOTIFICATION_MAX_AGE" default:"6h"`
+	// Number of times a notification is sent to a destination before the delivery is given up on, and the delay
+	// before the first retry. The delay doubles with every attempt
+	NotificationMaxAttempts uint          `envconfig:"NOTIFICATION_MAX_ATTEMPTS" default:"6"`
+	NotificationRetryDelay  time.Duration `envconfig:"NOTIFICATION_RETRY_DELAY" default:"30s"`
+	SlackActionBaseURL      string        `envconfig:"SLACK_ACTION_BASE_URL"`
+	// Signing secret of the Slack app that sends /neo slash commands. Slash commands are disabled when it is empty
+	SlackSigningSecret string `envconfig:"SLACK_SIGNING_SECRET"`
 
 	BackupEnabled bool `envconfig:"BACKUP_ENABLED" required:"true"`
 
+	// Name this process uses when reading from queue streams. Defaults to <hostname>-<command>-<pid>
+	QueueConsumerName string `envconfig:"QUEUE_CONSUMER_NAME"`
+	// How long a message may sit unacknowledged before another consumer is allowed to reclaim it
+	QueueVisibilityTimeout time.Duration `envconfig:"QUEUE_VISIBILITY_TIMEOUT" default:"5m"`
+
+	// Relative share of each importer batch given to each processing lane, i.e. live:8,manual:4,backfill:1.
+	// Lanes that are not listed receive a weight of 1
+	KillmailLaneWeights   map[string]int `envconfig:"KILLMAIL_LANE_WEIGHTS" default:"live:8,manual:4,backfill:1"`
+	KillmailSmallGangMax  int            `envconfig:"KILLMAIL_SMALL_GANG_MAX" default:"10"`
+	KillmailBlobThreshold int            `envconfig:"KILLMAIL_BLOB_THRESHOLD" default:"50"`
+
+	// How often the ESI importer walks the recent killmails of every stored SSO token
+	ESIKillmailImportInterval time.Duration `envconfig:"ESI_KILLMAIL_IMPORT_INTERVAL" default:"5m"`
+
+	// Number of times the importer will attempt to process a killmail before moving it to the dead letter queue
+	KillmailMaxAttempts uint `envconfig:"KILLMAIL_MAX_ATTEMPTS" default:"5"`
+	// Delay before the first retry of a failed killmail. The delay doubles with every further attempt
+	KillmailRetryDelay time.Duration `envconfig:"KILLMAIL_RETRY_DELAY" default:"30s"`
+
 	NewRelicAppName     string `envconfig:"NEW_RELIC_APP_NAME" required:"true"`
 	NewRelicLicensenKey string `envconfig:"NEW_RELIC_LICENSE_KEY" required:"true"`
 
@@ -0,0 +1,117 @@
+package neo
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// KillmailConnection is a page of a killmail list, ordered newest first, in the shape of a Relay connection
+type KillmailConnection struct {
+	Edges      []*KillmailEdge `json:"edges"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	TotalCount int64           `json:"totalCount"`
+}
+
+type KillmailEdge struct {
+	Cursor string    `json:"cursor"`
+	Node   *Killmail `json:"node"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+// ConnectionArgs are the Relay pagination arguments of a killmail connection. First and After page towards older
+// killmails, Last and Before page back towards newer killmails
+type ConnectionArgs struct {
+	First  int
+	After  *KillmailCursor
+	Last   int
+	Before *KillmailCursor
+}
+
+// Backward reports whether the arguments page back towards newer killmails
+func (a ConnectionArgs) Backward() bool {
+	return a.Last > 0 || a.Before != nil
+}
+
+// KillmailCursor is the position of a killmail in a list ordered by killmail time. Killmails that share a killmail
+// time are ordered by id, so that a cursor always points at a single killmail
+type KillmailCursor struct {
+	KillmailTime time.Time
+	ID           uint
+}
+
+func NewKillmailCursor(killmail *Killmail) *KillmailCursor {
+	return &KillmailCursor{
+		KillmailTime: killmail.KillmailTime,
+		ID:           killmail.ID,
+	}
+}
+
+// ParseKillmailCursor decodes a cursor previously returned by KillmailCursor.String
+func ParseKillmailCursor(cursor string) (*KillmailCursor, error) {
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(data), ":")
+	if len(parts) != 2 {
+		return nil, ErrInvalidCursor
+	}
+
+	nano, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &KillmailCursor{
+		KillmailTime: time.Unix(0, nano).UTC(),
+		ID:           uint(id),
+	}, nil
+
+}
+
+// String encodes the cursor into the opaque value that is handed out to clients
+func (c *KillmailCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", c.KillmailTime.UnixNano(), c.ID)))
+}
+
+// Older returns the operator matching the killmails that come after the cursor in a list ordered newest first
+func (c *KillmailCursor) Older() *Operator {
+	return c.operator(NewLessThanOperator)
+}
+
+// Newer returns the operator matching the killmails that come before the cursor in a list ordered newest first
+func (c *KillmailCursor) Newer() *Operator {
+	return c.operator(NewGreaterThanOperator)
+}
+
+func (c *KillmailCursor) operator(compare func(column string, value interface{}) *Operator) *Operator {
+	// The $or is wrapped in an $and so that it does not collide with the $or of the entity operators
+	return NewAndOperator(
+		NewOrOperator(
+			compare("killmailTime", c.KillmailTime),
+			NewAndOperator(
+				NewEqualOperator("killmailTime", c.KillmailTime),
+				compare("id", c.ID),
+			),
+		),
+	)
+}
@@ -0,0 +1,151 @@
+package neo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKillmailCursorRoundTrip(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		cursor KillmailCursor
+	}{
+		{"second precision", KillmailCursor{KillmailTime: time.Date(2020, 6, 1, 12, 30, 0, 0, time.UTC), ID: 84000000}},
+		{"nanosecond precision", KillmailCursor{KillmailTime: time.Date(2020, 6, 1, 12, 30, 0, 123456789, time.UTC), ID: 1}},
+		{"zero id", KillmailCursor{KillmailTime: time.Date(2007, 12, 5, 0, 0, 0, 0, time.UTC), ID: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := ParseKillmailCursor(tt.cursor.String())
+			if err != nil {
+				t.Fatalf("ParseKillmailCursor() error = %v", err)
+			}
+
+			if !parsed.KillmailTime.Equal(tt.cursor.KillmailTime) || parsed.ID != tt.cursor.ID {
+				t.Errorf("ParseKillmailCursor() = %v:%d, want %v:%d", parsed.KillmailTime, parsed.ID, tt.cursor.KillmailTime, tt.cursor.ID)
+			}
+		})
+	}
+
+}
+
+func TestParseKillmailCursorInvalid(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"empty", ""},
+		{"not base64", "!!!"},
+		{"missing id", "MTIzNA"},          // 1234
+		{"too many parts", "MTIzNDo1OjY"}, // 1234:5:6
+		{"non numeric time", "YWJjOjU"},   // abc:5
+		{"negative id", "MTIzNDotNQ"},     // 1234:-5
+		{"padded", "MTIzNDo1Ng=="},        // 1234:56
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseKillmailCursor(tt.cursor)
+			if err != ErrInvalidCursor {
+				t.Errorf("ParseKillmailCursor(%q) error = %v, want %v", tt.cursor, err, ErrInvalidCursor)
+			}
+		})
+	}
+
+}
+
+func TestKillmailCursorOlderNewer(t *testing.T) {
+
+	at := time.Date(2020, 6, 1, 12, 30, 0, 0, time.UTC)
+	cursor := &KillmailCursor{KillmailTime: at, ID: 5}
+
+	tests := []struct {
+		name         string
+		killmailTime time.Time
+		id           uint
+		older        bool
+		newer        bool
+	}{
+		{"cursor itself", at, 5, false, false},
+		{"same time lower id", at, 4, true, false},
+		{"same time higher id", at, 6, false, true},
+		{"earlier time higher id", at.Add(-time.Second), 9, true, false},
+		{"later time lower id", at.Add(time.Second), 1, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := evalCursorOperator(t, cursor.Older(), tt.killmailTime, tt.id); got != tt.older {
+				t.Errorf("Older() matched = %v, want %v", got, tt.older)
+			}
+			if got := evalCursorOperator(t, cursor.Newer(), tt.killmailTime, tt.id); got != tt.newer {
+				t.Errorf("Newer() matched = %v, want %v", got, tt.newer)
+			}
+		})
+	}
+
+}
+
+// evalCursorOperator evaluates a cursor operator against a killmail the way the query it builds would
+func evalCursorOperator(t *testing.T, op *Operator, killmailTime time.Time, id uint) bool {
+
+	t.Helper()
+
+	switch op.Operation {
+	case AndOp, OrOp:
+		children, ok := op.Value.([]*Operator)
+		if !ok {
+			t.Fatalf("%s operator holds %T, expected []*Operator", op.Operation, op.Value)
+		}
+
+		for _, child := range children {
+			matched := evalCursorOperator(t, child, killmailTime, id)
+			if op.Operation == AndOp && !matched {
+				return false
+			}
+			if op.Operation == OrOp && matched {
+				return true
+			}
+		}
+
+		return op.Operation == AndOp
+	}
+
+	var cmp int
+	switch op.Column {
+	case "killmailTime":
+		value := op.Value.(time.Time)
+		switch {
+		case killmailTime.Before(value):
+			cmp = -1
+		case killmailTime.After(value):
+			cmp = 1
+		}
+	case "id":
+		value := op.Value.(uint)
+		switch {
+		case id < value:
+			cmp = -1
+		case id > value:
+			cmp = 1
+		}
+	default:
+		t.Fatalf("unexpected column %q in cursor operator", op.Column)
+	}
+
+	switch op.Operation {
+	case EqualOp:
+		return cmp == 0
+	case LessThanOp:
+		return cmp < 0
+	case GreaterThanOp:
+		return cmp > 0
+	}
+
+	t.Fatalf("unexpected operation %q in cursor operator", op.Operation)
+	return false
+
+}
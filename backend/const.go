@@ -54,9 +54,16 @@ const REDIS_KILLMAIL = "neo:killmail:%d"
 const REDIS_KILLMAIL_ATTACKERS = "neo:killmail:%d:attackers"
 const REDIS_KILLMAIL_VICTIM = "neo:killmail:%d:victim"
 const REDIS_KILLMAIL_VICTIM_ITEMS = "neo:killmail:%d:victim:items"
-const REDIS_KILLMAILS_BY_ENTITY = "neo:killmails:${type}:${id}:${page}:${ops}"
+const REDIS_KILLMAILS_BY_ENTITY = "neo:killmails:${type}:${id}:${ops}"
+const REDIS_KILLMAILS_BY_ENTITY_COUNT = "neo:killmails:${type}:${id}:count:${ops}"
+const REDIS_RELATED_KILLMAILS = "neo:killmail:%d:related:%s:%d"
+
+const REDIS_SESSION = "neo:session:%s"
 
 const REDIS_MV_KILLMAILS = "neo:mv:killmails:${key}:${id}:${mods}"
+const REDIS_ACTIVITY = "neo:activity:${entity}:${id}:${days}"
+const REDIS_TOP_SHIPS = "neo:ships:${usage}:${grouping}:${entity}:${id}:${mods}"
+const REDIS_LEADERBOARD = "neo:leaderboard:${category}:${metric}:${period}:${scope}:${scopeID}"
 const REDIS_BLUEPRINT_MATERIALS = "neo:blueprint:materials:%d"
 const REDIS_BLUEPRINT_PRODUCT = "neo:blueprint:product:%d"
 const REDIS_BLUEPRINT_PRODUCTTYPEID = "neo:blueprint:producttypeid:%d"
@@ -69,20 +76,35 @@ const REDIS_TYPE_ATTRIBUTES = "neo:type:attributes:%d"
 const REDIS_TYPE_FLAG = "neo:type:flag:%d"
 const REDIS_TYPE_GROUP = "neo:type:group:%d"
 
-const BACKUP_KILLMAIL_RAW_PARENT_DIRECTORY_FORMAT = "static/killmails/raw/%s"
-const BACKUP_KILLMAIL_RAW_NAME_FORMAT = "%s/%d-%s.json"
+const BACKUP_KILLMAIL_RAW_DIRECTORY = "static/killmails/raw"
+const BACKUP_KILLMAIL_RAW_PARENT_DIRECTORY_FORMAT = "%s/%s" // Legacy one file per killmail layout
+const BACKUP_KILLMAIL_RAW_NAME_FORMAT = "%s/%d-%s.json"     // Legacy one file per killmail layout
+const BACKUP_KILLMAIL_STAGING_FORMAT = "%s/%s.ndjson"
+const BACKUP_KILLMAIL_ARCHIVE_FORMAT = "%s/%s.ndjson.zst"
+const BACKUP_KILLMAIL_INDEX_FORMAT = "%s/%s.index.json"
 
 // NEO Queues
 const QUEUE_STOP = "neo:queue:stop"
-const QUEUES_KILLMAIL_PROCESSING = "neo:killmails:processing"
 const QUEUES_KILLMAIL_DLQ = "neo:killmails:dlq"
+const QUEUES_KILLMAIL_RETRY = "neo:killmails:retry:%s"      // Failed messages of a lane scored by the unix time of their next attempt
+const QUEUES_NOTIFICATION_RETRY = "neo:notifications:retry" // Delivery ids scored by the unix time of their next attempt
+const REDIS_NOTIFICATION_SWEEP = "neo:notifications:sweep"
+const REDIS_TOKEN_REFRESH_LOCK = "neo:token:%d:refresh"
+const REDIS_STATS_COUNTED = "neo:stats:counted:%d" // Set once a killmail has been added to the stats counters
 const QUEUES_KILLMAIL_RECALCULATE = "neo:killmails:recalculate"
 const QUEUES_KILLMAIL_BACKUP = "neo:killmails:backup"
-const QUEUES_KILLMAIL_STATS = "neo:killmails:stats"
-const QUEUES_KILLMAIL_NOTIFICATION = "neo:notifications"
+
+// NEO Streams. These are consumed through the queue service using the QUEUE_CONSUMER_GROUP consumer group
+const QUEUE_CONSUMER_GROUP = "neo"
+const QUEUES_KILLMAIL_PROCESSING = "neo:stream:killmails:processing:%s" // Use Lane.Queue() to resolve the stream for a lane
+const QUEUES_KILLMAIL_STATS = "neo:stream:killmails:stats"
+const QUEUES_KILLMAIL_NOTIFICATION = "neo:stream:notifications"
 
 const WEBSOCKET_CONNECTIONS = "neo:websocket:connections"
 
+// Pub/Sub channel freshly imported killmails are published to for the GraphQL killmail feed
+const REDIS_KILLMAIL_FEED = "killmail-feed"
+
 // FlagIDs to FittingSlots
 
 var SLOT_TO_FLAGIDS = map[string]map[uint]bool{
@@ -0,0 +1,116 @@
+package neo
+
+// KillmailCriteria describes the killmails a subscriber is interested in. Every criteria that is set must match.
+// A killmail matches a list when it matches any of the ids in it, and the character, corporation and alliance
+// lists are combined so that a killmail involving any of those entities matches
+type KillmailCriteria struct {
+	CharacterIDs   []uint64 `bson:"characterIDs,omitempty" json:"characterIDs,omitempty"`
+	CorporationIDs []uint   `bson:"corporationIDs,omitempty" json:"corporationIDs,omitempty"`
+	AllianceIDs    []uint   `bson:"allianceIDs,omitempty" json:"allianceIDs,omitempty"`
+	// Side restricts the entity ids to the attackers or the victim. Both sides are checked when it is empty
+	Side KillmailSide `bson:"side,omitempty" json:"side,omitempty"`
+
+	RegionIDs      []uint `bson:"regionIDs,omitempty" json:"regionIDs,omitempty"`
+	SolarSystemIDs []uint `bson:"solarSystemIDs,omitempty" json:"solarSystemIDs,omitempty"`
+	// ShipGroupIDs and ShipTypeIDs are matched against the ship of the victim
+	ShipGroupIDs []uint `bson:"shipGroupIDs,omitempty" json:"shipGroupIDs,omitempty"`
+	ShipTypeIDs  []uint `bson:"shipTypeIDs,omitempty" json:"shipTypeIDs,omitempty"`
+
+	MinTotalValue float64 `bson:"minTotalValue,omitempty" json:"minTotalValue,omitempty"`
+	// MaxTotalValue is ignored when it is zero
+	MaxTotalValue float64 `bson:"maxTotalValue,omitempty" json:"maxTotalValue,omitempty"`
+	IsSolo        *bool   `bson:"isSolo,omitempty" json:"isSolo,omitempty"`
+	IsNPC         *bool   `bson:"isNPC,omitempty" json:"isNPC,omitempty"`
+	IsAwox        *bool   `bson:"isAwox,omitempty" json:"isAwox,omitempty"`
+}
+
+// Match reports whether the killmail meets the criteria
+func (c KillmailCriteria) Match(killmail *Killmail) bool {
+
+	if killmail.TotalValue < c.MinTotalValue {
+		return false
+	}
+
+	if c.MaxTotalValue > 0 && killmail.TotalValue > c.MaxTotalValue {
+		return false
+	}
+
+	if c.IsSolo != nil && killmail.IsSolo != *c.IsSolo {
+		return false
+	}
+
+	if c.IsNPC != nil && killmail.IsNPC != *c.IsNPC {
+		return false
+	}
+
+	if c.IsAwox != nil && killmail.IsAwox != *c.IsAwox {
+		return false
+	}
+
+	if len(c.RegionIDs) > 0 && !containsUint(c.RegionIDs, killmail.RegionID) {
+		return false
+	}
+
+	if len(c.SolarSystemIDs) > 0 && !containsUint(c.SolarSystemIDs, killmail.SolarSystemID) {
+		return false
+	}
+
+	if len(c.ShipGroupIDs) > 0 && (killmail.Victim == nil || !containsUint(c.ShipGroupIDs, killmail.Victim.ShipGroupID)) {
+		return false
+	}
+
+	if len(c.ShipTypeIDs) > 0 && (killmail.Victim == nil || !containsUint(c.ShipTypeIDs, killmail.Victim.ShipTypeID)) {
+		return false
+	}
+
+	if len(c.CharacterIDs) == 0 && len(c.CorporationIDs) == 0 && len(c.AllianceIDs) == 0 {
+		return true
+	}
+
+	if c.Side != KillmailSideAttacker && killmail.Victim != nil &&
+		c.matchEntity(killmail.Victim.CharacterID, killmail.Victim.CorporationID, killmail.Victim.AllianceID) {
+		return true
+	}
+
+	if c.Side != KillmailSideVictim {
+		for _, attacker := range killmail.Attackers {
+			if c.matchEntity(attacker.CharacterID, attacker.CorporationID, attacker.AllianceID) {
+				return true
+			}
+		}
+	}
+
+	return false
+
+}
+
+func (c KillmailCriteria) matchEntity(characterID *uint64, corporationID, allianceID *uint) bool {
+
+	if characterID != nil {
+		for _, id := range c.CharacterIDs {
+			if id == *characterID {
+				return true
+			}
+		}
+	}
+
+	if corporationID != nil && containsUint(c.CorporationIDs, *corporationID) {
+		return true
+	}
+
+	if allianceID != nil && containsUint(c.AllianceIDs, *allianceID) {
+		return true
+	}
+
+	return false
+
+}
+
+func containsUint(ids []uint, id uint) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package neo
+
+import "testing"
+
+func TestKillmailCriteriaMatch(t *testing.T) {
+
+	victimCharacter := uint64(1)
+	victimCorporation := uint(10)
+	attackerCharacter := uint64(2)
+	attackerAlliance := uint(200)
+	yes := true
+
+	killmail := &Killmail{
+		SolarSystemID: 30000142,
+		RegionID:      10000002,
+		TotalValue:    1000,
+		IsSolo:        true,
+		Victim: &KillmailVictim{
+			CharacterID:   &victimCharacter,
+			CorporationID: &victimCorporation,
+			ShipTypeID:    587,
+			ShipGroupID:   25,
+		},
+		Attackers: []*KillmailAttacker{
+			{CharacterID: &attackerCharacter, AllianceID: &attackerAlliance},
+		},
+	}
+
+	withoutVictim := &Killmail{
+		TotalValue: 1000,
+		Attackers:  killmail.Attackers,
+	}
+
+	tests := []struct {
+		name     string
+		criteria KillmailCriteria
+		killmail *Killmail
+		want     bool
+	}{
+		{"empty criteria", KillmailCriteria{}, killmail, true},
+		{"victim character on either side", KillmailCriteria{CharacterIDs: []uint64{1}}, killmail, true},
+		{"attacker alliance on either side", KillmailCriteria{AllianceIDs: []uint{200}}, killmail, true},
+		{"unknown character", KillmailCriteria{CharacterIDs: []uint64{3}}, killmail, false},
+		{"victim character on victim side", KillmailCriteria{CharacterIDs: []uint64{1}, Side: KillmailSideVictim}, killmail, true},
+		{"victim character on attacker side", KillmailCriteria{CharacterIDs: []uint64{1}, Side: KillmailSideAttacker}, killmail, false},
+		{"attacker character on attacker side", KillmailCriteria{CharacterIDs: []uint64{2}, Side: KillmailSideAttacker}, killmail, true},
+		{"attacker character on victim side", KillmailCriteria{CharacterIDs: []uint64{2}, Side: KillmailSideVictim}, killmail, false},
+		{"entity lists are combined", KillmailCriteria{CharacterIDs: []uint64{3}, CorporationIDs: []uint{10}}, killmail, true},
+		{"below min value", KillmailCriteria{MinTotalValue: 1001}, killmail, false},
+		{"above max value", KillmailCriteria{MaxTotalValue: 999}, killmail, false},
+		{"zero max value is ignored", KillmailCriteria{MinTotalValue: 1000}, killmail, true},
+		{"solo", KillmailCriteria{IsSolo: &yes}, killmail, true},
+		{"npc", KillmailCriteria{IsNPC: &yes}, killmail, false},
+		{"region", KillmailCriteria{RegionIDs: []uint{10000002}}, killmail, true},
+		{"other system", KillmailCriteria{SolarSystemIDs: []uint{30000144}}, killmail, false},
+		{"victim ship group", KillmailCriteria{ShipGroupIDs: []uint{25}}, killmail, true},
+		{"victim ship type", KillmailCriteria{ShipTypeIDs: []uint{588}}, killmail, false},
+		{"ship group without victim", KillmailCriteria{ShipGroupIDs: []uint{25}}, withoutVictim, false},
+		{"ship type without victim", KillmailCriteria{ShipTypeIDs: []uint{587}}, withoutVictim, false},
+		{"victim side without victim", KillmailCriteria{CharacterIDs: []uint64{1}, Side: KillmailSideVictim}, withoutVictim, false},
+		{"attackers without victim", KillmailCriteria{AllianceIDs: []uint{200}}, withoutVictim, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.criteria.Match(tt.killmail); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+}
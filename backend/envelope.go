@@ -2,6 +2,7 @@ package neo
 
 import (
 	"encoding/json"
+	"fmt"
 )
 
 // Killmail Envelope is a container around a raw killmail with the ID and Hash extracted
@@ -11,7 +12,45 @@ type Envelope struct {
 	Killmail json.RawMessage `json:"killmail"`
 }
 
+// Message is the payload that is pushed onto the killmail queues. Attempts, LastError and LastAttempt
+// are only populated once a message has failed processing at least once
 type Message struct {
-	ID   uint   `json:"id"`
-	Hash string `json:"hash"`
+	ID          uint   `json:"id"`
+	Hash        string `json:"hash"`
+	Attempts    uint   `json:"attempts,omitempty"`
+	LastError   string `json:"lastError,omitempty"`
+	LastAttempt int64  `json:"lastAttempt,omitempty"`
+}
+
+// Lane is a named processing queue. Live killmails from the zKillboard websocket, manually dispatched killmails
+// and historical backfills are kept in separate lanes so that a large backfill cannot delay live killmails
+type Lane string
+
+const (
+	LaneLive     Lane = "live"
+	LaneManual   Lane = "manual"
+	LaneBackfill Lane = "backfill"
+)
+
+var AllLanes = []Lane{
+	LaneLive,
+	LaneManual,
+	LaneBackfill,
+}
+
+func (l Lane) IsValid() bool {
+	switch l {
+	case LaneLive, LaneManual, LaneBackfill:
+		return true
+	}
+	return false
+}
+
+func (l Lane) String() string {
+	return string(l)
+}
+
+// Queue returns the name of the stream that backs this lane
+func (l Lane) Queue() string {
+	return fmt.Sprintf(QUEUES_KILLMAIL_PROCESSING, l)
 }
@@ -23,6 +23,7 @@ require (
 	github.com/jinzhu/copier v0.0.0-20190924061706-b57f9002281a
 	github.com/joho/godotenv v1.3.0
 	github.com/kelseyhightower/envconfig v1.4.0
+	github.com/klauspost/compress v1.9.5
 	github.com/korovkin/limiter v0.0.0-20190919045942-dac5a6b2a536
 	github.com/kr/pretty v0.2.0 // indirect
 	github.com/lestrrat-go/jwx v0.9.1
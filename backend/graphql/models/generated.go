@@ -7,8 +7,21 @@ import (
 	"io"
 	"strconv"
 	"time"
+
+	"github.com/eveisesi/neo"
 )
 
+type BattleReportFilter struct {
+	From            time.Time        `json:"from"`
+	To              time.Time        `json:"to"`
+	Scope           *neo.BattleScope `json:"scope"`
+	SolarSystemID   *int             `json:"solarSystemID"`
+	ConstellationID *int             `json:"constellationID"`
+	RegionID        *int             `json:"regionID"`
+	Window          *int             `json:"window"`
+	MinKillmails    *int             `json:"minKillmails"`
+}
+
 type BooleanFilterInput struct {
 	Eq *bool `json:"eq"`
 	Ne *bool `json:"ne"`
@@ -23,6 +36,20 @@ type IntFilterInput struct {
 	Gte *int `json:"gte"`
 }
 
+type KillmailFeedFilter struct {
+	CharacterIDs   []int             `json:"characterIDs"`
+	CorporationIDs []int             `json:"corporationIDs"`
+	AllianceIDs    []int             `json:"allianceIDs"`
+	Side           *neo.KillmailSide `json:"side"`
+	RegionIDs      []int             `json:"regionIDs"`
+	SolarSystemIDs []int             `json:"solarSystemIDs"`
+	ShipGroupIDs   []int             `json:"shipGroupIDs"`
+	MinTotalValue  *float64          `json:"minTotalValue"`
+	IsSolo         *bool             `json:"isSolo"`
+	IsNpc          *bool             `json:"isNPC"`
+	IsAwox         *bool             `json:"isAwox"`
+}
+
 type KillmailFilter struct {
 	MoonID                 *IntFilterInput     `json:"moonID"`
 	SolarSystemID          *IntFilterInput     `json:"solarSystemID"`
@@ -30,6 +57,7 @@ type KillmailFilter struct {
 	IsNpc                  *BooleanFilterInput `json:"isNPC"`
 	IsAwox                 *BooleanFilterInput `json:"isAwox"`
 	IsSolo                 *BooleanFilterInput `json:"isSolo"`
+	Tags                   *TagFilterInput     `json:"tags"`
 	DroppedValue           *IntFilterInput     `json:"droppedValue"`
 	DestroyedValue         *IntFilterInput     `json:"destroyedValue"`
 	FittedValue            *IntFilterInput     `json:"fittedValue"`
@@ -50,6 +78,11 @@ type KillmailFilter struct {
 	VictimShiptypeID       *IntFilterInput     `json:"victimShiptypeID"`
 }
 
+type TagFilterInput struct {
+	In  []neo.KillmailTag `json:"in"`
+	Nin []neo.KillmailTag `json:"nin"`
+}
+
 type TimeFilterInput struct {
 	Eq  *time.Time `json:"eq"`
 	Ne  *time.Time `json:"ne"`
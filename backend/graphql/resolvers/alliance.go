@@ -20,3 +20,7 @@ type allianceResolver struct{ *Resolver }
 func (r *allianceResolver) MemberCount(ctx context.Context, obj *neo.Alliance) (int, error) {
 	return r.Services.MemberCountByAllianceID(ctx, obj.ID)
 }
+
+func (r *allianceResolver) Stats(ctx context.Context, obj *neo.Alliance, period *neo.StatPeriod) (*neo.EntityStats, error) {
+	return r.Services.EntityStats(ctx, neo.StatEntityAlliance, uint64(obj.ID), statPeriod(period))
+}
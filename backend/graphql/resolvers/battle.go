@@ -0,0 +1,100 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/graphql/models"
+	"github.com/eveisesi/neo/graphql/service"
+	"github.com/eveisesi/neo/services/battle"
+)
+
+func (r *queryResolver) BattleReports(ctx context.Context, filter models.BattleReportFilter) ([]*neo.BattleReport, error) {
+
+	f := battle.Filter{
+		From: filter.From,
+		To:   filter.To,
+	}
+
+	if filter.Scope != nil {
+		f.Scope = *filter.Scope
+	}
+
+	if filter.SolarSystemID != nil {
+		f.SolarSystemID = uint(*filter.SolarSystemID)
+	}
+
+	if filter.ConstellationID != nil {
+		f.ConstellationID = uint(*filter.ConstellationID)
+	}
+
+	if filter.RegionID != nil {
+		f.RegionID = uint(*filter.RegionID)
+	}
+
+	if filter.Window != nil {
+		f.Window = time.Duration(*filter.Window) * time.Minute
+	}
+
+	if filter.MinKillmails != nil {
+		f.MinKillmails = *filter.MinKillmails
+	}
+
+	return r.Services.BattleReports(ctx, f)
+
+}
+
+func (r *queryResolver) BattleReport(ctx context.Context, id string) (*neo.BattleReport, error) {
+	return r.Services.Battle.BattleReport(ctx, id)
+}
+
+func (r *Resolver) BattleReport() service.BattleReportResolver {
+	return &battleReportResolver{r}
+}
+
+type battleReportResolver struct{ *Resolver }
+
+func (r *battleReportResolver) System(ctx context.Context, obj *neo.BattleReport) (*neo.SolarSystem, error) {
+	if obj.Scope != neo.BattleScopeSystem {
+		return nil, nil
+	}
+	return r.Dataloader(ctx).SolarSystemLoader.Load(obj.LocationID)
+}
+
+func (r *battleReportResolver) Constellation(ctx context.Context, obj *neo.BattleReport) (*neo.Constellation, error) {
+	if obj.Scope != neo.BattleScopeConstellation {
+		return nil, nil
+	}
+	return r.Dataloader(ctx).ConstellationLoader.Load(obj.LocationID)
+}
+
+func (r *Resolver) BattleSide() service.BattleSideResolver {
+	return &battleSideResolver{r}
+}
+
+type battleSideResolver struct{ *Resolver }
+
+func (r *battleSideResolver) Pilots(ctx context.Context, obj *neo.BattleSide) (int, error) {
+	return len(obj.CharacterIDs), nil
+}
+
+func (r *battleSideResolver) Alliances(ctx context.Context, obj *neo.BattleSide) ([]*neo.Alliance, error) {
+	alliances, errs := r.Dataloader(ctx).AllianceLoader.LoadAll(obj.AllianceIDs)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return alliances, nil
+}
+
+func (r *battleSideResolver) Corporations(ctx context.Context, obj *neo.BattleSide) ([]*neo.Corporation, error) {
+	corporations, errs := r.Dataloader(ctx).CorporationLoader.LoadAll(obj.CorporationIDs)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return corporations, nil
+}
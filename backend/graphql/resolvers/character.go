@@ -20,3 +20,7 @@ type characterResolver struct{ *Resolver }
 func (r *characterResolver) Corporation(ctx context.Context, obj *neo.Character) (*neo.Corporation, error) {
 	return r.Dataloader(ctx).CorporationLoader.Load(obj.CorporationID)
 }
+
+func (r *characterResolver) Stats(ctx context.Context, obj *neo.Character, period *neo.StatPeriod) (*neo.EntityStats, error) {
+	return r.Services.EntityStats(ctx, neo.StatEntityCharacter, obj.ID, statPeriod(period))
+}
@@ -25,3 +25,7 @@ func (r *corporationResolver) Alliance(ctx context.Context, obj *neo.Corporation
 	// }
 	// return r.Dataloader(ctx).AllianceLoader.Load(obj.AllianceID.Uint)
 }
+
+func (r *corporationResolver) Stats(ctx context.Context, obj *neo.Corporation, period *neo.StatPeriod) (*neo.EntityStats, error) {
+	return r.Services.EntityStats(ctx, neo.StatEntityCorporation, uint64(obj.ID), statPeriod(period))
+}
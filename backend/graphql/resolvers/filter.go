@@ -90,6 +90,10 @@ func buildOperators(filter interface{}) (mods []*neo.Operator, err error) {
 				if mod := getBoolOperator(colName, n, iv); mod != nil {
 					mods = append(mods, mod)
 				}
+			case "TagFilterInput":
+				if mod := getTagOperator(colName, n, iv); mod != nil {
+					mods = append(mods, mod)
+				}
 			default:
 				panic("unsupported filter type")
 			}
@@ -138,6 +142,24 @@ func getBoolOperator(col, op string, v reflect.Value) *neo.Operator {
 	return nil
 }
 
+// Determine the tag modifier. Tags are stored as an array, so In matches killmails carrying any of the
+// provided tags and Nin matches killmails carrying none of them
+func getTagOperator(col, op string, v reflect.Value) *neo.Operator {
+	values := make([]neo.OpValue, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		values = append(values, v.Index(i).String())
+	}
+
+	switch op {
+	case "In":
+		return neo.NewInOperator(col, values)
+	case "Nin":
+		return neo.NewNotInOperator(col, values)
+	}
+
+	return nil
+}
+
 // Determine the int modifier
 func getIntOperator(col, op string, v reflect.Value) *neo.Operator {
 	switch op {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/eveisesi/neo"
 	"github.com/eveisesi/neo/graphql/models"
@@ -86,48 +87,93 @@ func (r *queryResolver) MvByEntityID(ctx context.Context, category *models.Categ
 
 }
 
-func (r *queryResolver) KillmailsByEntityID(ctx context.Context, entity models.Entity, id int, page *int, filter *models.KillmailFilter) ([]*neo.Killmail, error) {
+func (r *queryResolver) KillmailsByEntityID(ctx context.Context, entity models.Entity, id int, first *int, after *string, last *int, before *string, filter *models.KillmailFilter) (*neo.KillmailConnection, error) {
 
-	var mails []*neo.Killmail
+	var connection *neo.KillmailConnection
 
 	ops, err := buildOperators(filter)
 	if err != nil {
 		return nil, err
 	}
 
+	args, err := connectionArgs(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
 	switch entity {
 	case models.EntityAll:
 		return nil, errors.New("All Type is not supported on this query")
 	case models.EntityCharacter:
-		mails, err = r.Services.KillmailsByCharacterID(ctx, uint64(id), uint(*page), ops...)
+		connection, err = r.Services.KillmailsByCharacterID(ctx, uint64(id), args, ops...)
 	case models.EntityCorporation:
-		mails, err = r.Services.KillmailsByCorporationID(ctx, uint(id), uint(*page), ops...)
+		connection, err = r.Services.KillmailsByCorporationID(ctx, uint(id), args, ops...)
 	case models.EntityAlliance:
-		mails, err = r.Services.KillmailsByAllianceID(ctx, uint(id), uint(*page), ops...)
+		connection, err = r.Services.KillmailsByAllianceID(ctx, uint(id), args, ops...)
 	case models.EntityShip:
-		mails, err = r.Services.KillmailsByShipID(ctx, uint(id), uint(*page), ops...)
+		connection, err = r.Services.KillmailsByShipID(ctx, uint(id), args, ops...)
 	case models.EntityShipGroup:
-		mails, err = r.Services.KillmailsByShipGroupID(ctx, uint(id), uint(*page), ops...)
+		connection, err = r.Services.KillmailsByShipGroupID(ctx, uint(id), args, ops...)
 	case models.EntitySystem:
-		mails, err = r.Services.KillmailsBySystemID(ctx, uint(id), uint(*page), ops...)
+		connection, err = r.Services.KillmailsBySystemID(ctx, uint(id), args, ops...)
 	case models.EntityConstellation:
-		mails, err = r.Services.KillmailsByConstellationID(ctx, uint(id), uint(*page), ops...)
+		connection, err = r.Services.KillmailsByConstellationID(ctx, uint(id), args, ops...)
 	case models.EntityRegion:
-		mails, err = r.Services.KillmailsByRegionID(ctx, uint(id), uint(*page), ops...)
+		connection, err = r.Services.KillmailsByRegionID(ctx, uint(id), args, ops...)
 	default:
 		return nil, errors.New("invalid entity")
 	}
 
-	return mails, err
+	return connection, err
+}
+
+// connectionArgs decodes the Relay pagination arguments of a connection query. Paging in both directions at once is
+// not supported
+func connectionArgs(first *int, after *string, last *int, before *string) (neo.ConnectionArgs, error) {
+
+	var args neo.ConnectionArgs
+	var err error
+
+	if (first != nil || after != nil) && (last != nil || before != nil) {
+		return args, errors.New("first and after cannot be combined with last and before")
+	}
+
+	if first != nil {
+		args.First = *first
+	}
+	if last != nil {
+		args.Last = *last
+	}
+
+	if after != nil {
+		args.After, err = neo.ParseKillmailCursor(*after)
+		if err != nil {
+			return args, err
+		}
+	}
+	if before != nil {
+		args.Before, err = neo.ParseKillmailCursor(*before)
+		if err != nil {
+			return args, err
+		}
+	}
+
+	return args, nil
+
 }
 
-func (r *subscriptionResolver) KillmailFeed(ctx context.Context) (<-chan *neo.Killmail, error) {
+func (r *subscriptionResolver) KillmailFeed(ctx context.Context, filter *models.KillmailFeedFilter) (<-chan *neo.Killmail, error) {
 	feedCh := make(chan *neo.Killmail)
 
+	var criteria neo.KillmailCriteria
+	if filter != nil {
+		criteria = feedCriteria(filter)
+	}
+
 	go func(ctx context.Context, output chan *neo.Killmail) {
 		r.Logger.Println("hello")
 		r.Redis.Incr(ctx, neo.WEBSOCKET_CONNECTIONS)
-		feed := r.Redis.Subscribe(ctx, "killmail-feed")
+		feed := r.Redis.Subscribe(ctx, neo.REDIS_KILLMAIL_FEED)
 
 		_, err := feed.Receive(ctx)
 		if err != nil {
@@ -151,15 +197,61 @@ func (r *subscriptionResolver) KillmailFeed(ctx context.Context) (<-chan *neo.Ki
 				err = json.Unmarshal([]byte(msg.Payload), killmail)
 				if err != nil {
 					r.Logger.WithError(err).Error("failed to unmarshal feed payload")
+					break
+				}
+
+				if !criteria.Match(killmail) {
+					break
 				}
+
 				output <- killmail
-				break
 			}
 		}
 
 	}(ctx, feedCh)
 
-	return feedCh, err
+	return feedCh, nil
+
+}
+
+func feedCriteria(filter *models.KillmailFeedFilter) neo.KillmailCriteria {
+
+	f := neo.KillmailCriteria{
+		IsSolo: filter.IsSolo,
+		IsNPC:  filter.IsNpc,
+		IsAwox: filter.IsAwox,
+	}
+
+	for _, id := range filter.CharacterIDs {
+		f.CharacterIDs = append(f.CharacterIDs, uint64(id))
+	}
+
+	f.CorporationIDs = uintSlice(filter.CorporationIDs)
+	f.AllianceIDs = uintSlice(filter.AllianceIDs)
+	f.RegionIDs = uintSlice(filter.RegionIDs)
+	f.SolarSystemIDs = uintSlice(filter.SolarSystemIDs)
+	f.ShipGroupIDs = uintSlice(filter.ShipGroupIDs)
+
+	if filter.Side != nil {
+		f.Side = *filter.Side
+	}
+
+	if filter.MinTotalValue != nil {
+		f.MinTotalValue = *filter.MinTotalValue
+	}
+
+	return f
+
+}
+
+func uintSlice(ids []int) []uint {
+
+	out := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, uint(id))
+	}
+
+	return out
 
 }
 
@@ -185,3 +277,40 @@ func (r *killmailResolver) Attackers(ctx context.Context, obj *neo.Killmail, fin
 
 	return obj.Attackers, nil
 }
+
+func (r *killmailResolver) Tags(ctx context.Context, obj *neo.Killmail) ([]neo.KillmailTag, error) {
+	// Killmails that have not been classified yet have no tags stored
+	if obj.Tags == nil {
+		return []neo.KillmailTag{}, nil
+	}
+
+	return obj.Tags, nil
+}
+
+func (r *killmailResolver) Related(ctx context.Context, obj *neo.Killmail, windowMinutes *int, radius *neo.RelatedRadius) ([]*neo.RelatedKillmails, error) {
+	// Arguments explicitly set to null fall back to the defaults of the service
+	var window time.Duration
+	if windowMinutes != nil {
+		window = time.Duration(*windowMinutes) * time.Minute
+	}
+
+	var rad neo.RelatedRadius
+	if radius != nil {
+		rad = *radius
+	}
+
+	return r.Services.RelatedKillmails(ctx, obj, window, rad)
+}
+
+func (r *Resolver) RelatedKillmails() service.RelatedKillmailsResolver {
+	return &relatedKillmailsResolver{r}
+}
+
+type relatedKillmailsResolver struct{ *Resolver }
+
+func (r *relatedKillmailsResolver) Alliance(ctx context.Context, obj *neo.RelatedKillmails) (*neo.Alliance, error) {
+	if obj.AllianceID == nil {
+		return nil, nil
+	}
+	return r.Dataloader(ctx).AllianceLoader.Load(*obj.AllianceID)
+}
@@ -0,0 +1,105 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/graphql/models"
+	"github.com/eveisesi/neo/graphql/service"
+)
+
+func (r *queryResolver) Leaderboard(ctx context.Context, category models.Entity, scope *models.Entity, scopeID *int, period *neo.LeaderboardPeriod, metric *neo.LeaderboardMetric, limit *int) (*neo.Leaderboard, error) {
+
+	var s neo.StatEntity
+	var id uint64
+	if scope != nil && *scope != models.EntityAll {
+		if scopeID == nil {
+			return nil, fmt.Errorf("scopeID is required when the leaderboard is scoped to %s", *scope)
+		}
+
+		s, id = neo.StatEntity(*scope), uint64(*scopeID)
+	}
+
+	p := neo.LeaderboardPeriodLast7Days
+	if period != nil {
+		p = *period
+	}
+
+	m := neo.LeaderboardMetricKills
+	if metric != nil {
+		m = *metric
+	}
+
+	l := 10
+	if limit != nil {
+		l = *limit
+	}
+
+	return r.Services.Leaderboard(ctx, neo.StatEntity(category), s, id, p, m, l)
+
+}
+
+func (r *Resolver) Leaderboard() service.LeaderboardResolver {
+	return &leaderboardResolver{r}
+}
+
+type leaderboardResolver struct{ *Resolver }
+
+func (r *leaderboardResolver) Category(ctx context.Context, obj *neo.Leaderboard) (models.Entity, error) {
+	return models.Entity(obj.Category), nil
+}
+
+func (r *leaderboardResolver) Scope(ctx context.Context, obj *neo.Leaderboard) (models.Entity, error) {
+	if obj.Scope == "" {
+		return models.EntityAll, nil
+	}
+
+	return models.Entity(obj.Scope), nil
+}
+
+func (r *Resolver) LeaderboardEntry() service.LeaderboardEntryResolver {
+	return &leaderboardEntryResolver{r}
+}
+
+type leaderboardEntryResolver struct{ *Resolver }
+
+func (r *leaderboardEntryResolver) Character(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.Character, error) {
+	if obj.Category != neo.StatEntityCharacter {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).CharacterLoader.Load(obj.ID)
+}
+
+func (r *leaderboardEntryResolver) Corporation(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.Corporation, error) {
+	if obj.Category != neo.StatEntityCorporation {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).CorporationLoader.Load(uint(obj.ID))
+}
+
+func (r *leaderboardEntryResolver) Alliance(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.Alliance, error) {
+	if obj.Category != neo.StatEntityAlliance {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).AllianceLoader.Load(uint(obj.ID))
+}
+
+func (r *leaderboardEntryResolver) Type(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.Type, error) {
+	if obj.Category != neo.StatEntityShip {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).TypeLoader.Load(uint(obj.ID))
+}
+
+func (r *leaderboardEntryResolver) SolarSystem(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.SolarSystem, error) {
+	if obj.Category != neo.StatEntitySystem {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).SolarSystemLoader.Load(uint(obj.ID))
+}
@@ -5,10 +5,14 @@ import (
 
 	"github.com/eveisesi/neo"
 	"github.com/eveisesi/neo/services/alliance"
+	"github.com/eveisesi/neo/services/battle"
 	"github.com/eveisesi/neo/services/character"
 	"github.com/eveisesi/neo/services/corporation"
 	"github.com/eveisesi/neo/services/search"
+	"github.com/eveisesi/neo/services/stats"
+	"github.com/eveisesi/neo/services/token"
 	"github.com/eveisesi/neo/services/universe"
+	"github.com/eveisesi/neo/services/watchlist"
 	"github.com/go-redis/redis/v8"
 	"github.com/sirupsen/logrus"
 
@@ -22,6 +26,7 @@ import (
 type Resolver struct {
 	Services   Services
 	Dataloader func(ctx context.Context) dataloaders.Loaders
+	CtxViewer  func(ctx context.Context) *neo.Viewer
 	Logger     *logrus.Logger
 	Redis      *redis.Client
 }
@@ -32,6 +37,10 @@ type Corporation corporation.Service
 type Character character.Service
 type Universe universe.Service
 type Search search.Service
+type Stats stats.Service
+type Battle battle.Service
+type Token token.Service
+type Watchlist watchlist.Service
 
 type Services struct {
 	Killmail
@@ -40,6 +49,10 @@ type Services struct {
 	Character
 	Universe
 	Search
+	Stats
+	Battle
+	Token
+	Watchlist
 }
 
 type FeedManager struct {
@@ -53,6 +66,7 @@ type FeedManager struct {
 
 func NewResolver(
 	ctxLoaders func(ctx context.Context) dataloaders.Loaders,
+	ctxViewer func(ctx context.Context) *neo.Viewer,
 	logger *logrus.Logger,
 	redis *redis.Client,
 	services Services,
@@ -64,6 +78,7 @@ func NewResolver(
 	return &Resolver{
 		Services:   services,
 		Dataloader: ctxLoaders,
+		CtxViewer:  ctxViewer,
 		Logger:     logger,
 		Redis:      redis,
 	}
@@ -90,10 +105,6 @@ type subscriptionResolver struct{ *Resolver }
 
 type mutationResolver struct{ *Resolver }
 
-func (r *mutationResolver) MutationPlaceholder(ctx context.Context) (bool, error) {
-	panic("not implemented")
-}
-
 type queryResolver struct{ *Resolver }
 
 func (r *queryResolver) QueryPlaceholder(ctx context.Context) (bool, error) {
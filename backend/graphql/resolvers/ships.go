@@ -0,0 +1,63 @@
+package resolvers
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/graphql/models"
+	"github.com/eveisesi/neo/graphql/service"
+)
+
+func (r *queryResolver) TopShips(ctx context.Context, entity models.Entity, id int, usage neo.ShipUsage, grouping *neo.ShipGrouping, period *neo.StatPeriod, limit *int, filter *models.KillmailFilter) ([]*neo.ShipCount, error) {
+
+	ops, err := buildOperators(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	g := neo.ShipGroupingType
+	if grouping != nil {
+		g = *grouping
+	}
+
+	p := neo.StatPeriodLast30Days
+	if period != nil {
+		p = *period
+	}
+
+	l := 10
+	if limit != nil {
+		l = *limit
+	}
+
+	return r.Services.TopShips(ctx, neo.StatEntity(entity), uint64(id), usage, g, p.Since(time.Now()), l, ops...)
+
+}
+
+func (r *Resolver) ShipCount() service.ShipCountResolver {
+	return &shipCountResolver{r}
+}
+
+type shipCountResolver struct{ *Resolver }
+
+func (r *shipCountResolver) Type(ctx context.Context, obj *neo.ShipCount) (*neo.Type, error) {
+	if obj.Grouping != neo.ShipGroupingType {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).TypeLoader.Load(obj.ID)
+}
+
+func (r *shipCountResolver) Group(ctx context.Context, obj *neo.ShipCount) (*neo.TypeGroup, error) {
+	if obj.Grouping == neo.ShipGroupingGroup {
+		return r.Dataloader(ctx).TypeGroupLoader.Load(obj.ID)
+	}
+
+	ship, err := r.Dataloader(ctx).TypeLoader.Load(obj.ID)
+	if err != nil || ship == nil {
+		return nil, err
+	}
+
+	return r.Dataloader(ctx).TypeGroupLoader.Load(ship.GroupID)
+}
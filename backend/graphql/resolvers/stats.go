@@ -0,0 +1,27 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/graphql/models"
+)
+
+func (r *queryResolver) Activity(ctx context.Context, entity models.Entity, id int, days *int) (*neo.Activity, error) {
+
+	d := 30
+	if days != nil {
+		d = *days
+	}
+
+	return r.Services.Killmail.Activity(ctx, neo.StatEntity(entity), uint64(id), d)
+
+}
+
+// statPeriod defaults a missing period to all time
+func statPeriod(period *neo.StatPeriod) neo.StatPeriod {
+	if period == nil {
+		return neo.StatPeriodAllTime
+	}
+	return *period
+}
@@ -0,0 +1,90 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/graphql/service"
+)
+
+var errUnauthenticated = errors.New("authentication required")
+
+func (r *queryResolver) Me(ctx context.Context) (*neo.Viewer, error) {
+	return r.CtxViewer(ctx), nil
+}
+
+func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
+
+	viewer := r.CtxViewer(ctx)
+	if viewer == nil {
+		return false, errUnauthenticated
+	}
+
+	err := r.Services.RevokeSession(ctx, viewer.SessionID)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+
+}
+
+func (r *Resolver) Viewer() service.ViewerResolver {
+	return &viewerResolver{r}
+}
+
+type viewerResolver struct{ *Resolver }
+
+func (r *viewerResolver) Character(ctx context.Context, obj *neo.Viewer) (*neo.Character, error) {
+	return r.Dataloader(ctx).CharacterLoader.Load(obj.CharacterID)
+}
+
+func (r *viewerResolver) Corporation(ctx context.Context, obj *neo.Viewer) (*neo.Corporation, error) {
+
+	character, err := r.Dataloader(ctx).CharacterLoader.Load(obj.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Dataloader(ctx).CorporationLoader.Load(character.CorporationID)
+
+}
+
+func (r *viewerResolver) Alliance(ctx context.Context, obj *neo.Viewer) (*neo.Alliance, error) {
+
+	character, err := r.Dataloader(ctx).CharacterLoader.Load(obj.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if character.AllianceID == nil {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).AllianceLoader.Load(*character.AllianceID)
+
+}
+
+func (r *viewerResolver) Characters(ctx context.Context, obj *neo.Viewer) ([]*neo.Character, error) {
+
+	tokens, err := r.Services.Account(ctx, obj.CharacterID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(tokens))
+	for _, token := range tokens {
+		ids = append(ids, token.ID)
+	}
+
+	characters, errs := r.Dataloader(ctx).CharacterLoader.LoadAll(ids)
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return characters, nil
+
+}
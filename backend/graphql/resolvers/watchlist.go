@@ -0,0 +1,96 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/graphql/service"
+)
+
+func (r *queryResolver) WatchlistKillmails(ctx context.Context, first *int, after *string, last *int, before *string) (*neo.KillmailConnection, error) {
+
+	viewer := r.CtxViewer(ctx)
+	if viewer == nil {
+		return nil, errUnauthenticated
+	}
+
+	args, err := connectionArgs(first, after, last, before)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Services.Watchlist.Killmails(ctx, viewer.CharacterID, args)
+
+}
+
+func (r *mutationResolver) Watch(ctx context.Context, entity neo.WatchEntity, id int) (*neo.WatchlistEntry, error) {
+
+	viewer := r.CtxViewer(ctx)
+	if viewer == nil {
+		return nil, errUnauthenticated
+	}
+
+	return r.Services.Watchlist.Watch(ctx, viewer.CharacterID, entity, uint64(id))
+
+}
+
+func (r *mutationResolver) Unwatch(ctx context.Context, entity neo.WatchEntity, id int) (bool, error) {
+
+	viewer := r.CtxViewer(ctx)
+	if viewer == nil {
+		return false, errUnauthenticated
+	}
+
+	return r.Services.Watchlist.Unwatch(ctx, viewer.CharacterID, entity, uint64(id))
+
+}
+
+func (r *viewerResolver) Watchlist(ctx context.Context, obj *neo.Viewer) ([]*neo.WatchlistEntry, error) {
+	return r.Services.Watchlist.Watchlist(ctx, obj.CharacterID)
+}
+
+func (r *Resolver) WatchlistEntry() service.WatchlistEntryResolver {
+	return &watchlistEntryResolver{r}
+}
+
+type watchlistEntryResolver struct{ *Resolver }
+
+func (r *watchlistEntryResolver) Character(ctx context.Context, obj *neo.WatchlistEntry) (*neo.Character, error) {
+	if obj.Entity != neo.WatchEntityCharacter {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).CharacterLoader.Load(obj.EntityID)
+}
+
+func (r *watchlistEntryResolver) Corporation(ctx context.Context, obj *neo.WatchlistEntry) (*neo.Corporation, error) {
+	if obj.Entity != neo.WatchEntityCorporation {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).CorporationLoader.Load(uint(obj.EntityID))
+}
+
+func (r *watchlistEntryResolver) Alliance(ctx context.Context, obj *neo.WatchlistEntry) (*neo.Alliance, error) {
+	if obj.Entity != neo.WatchEntityAlliance {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).AllianceLoader.Load(uint(obj.EntityID))
+}
+
+func (r *watchlistEntryResolver) System(ctx context.Context, obj *neo.WatchlistEntry) (*neo.SolarSystem, error) {
+	if obj.Entity != neo.WatchEntitySystem {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).SolarSystemLoader.Load(uint(obj.EntityID))
+}
+
+func (r *watchlistEntryResolver) Ship(ctx context.Context, obj *neo.WatchlistEntry) (*neo.Type, error) {
+	if obj.Entity != neo.WatchEntityShip {
+		return nil, nil
+	}
+
+	return r.Dataloader(ctx).TypeLoader.Load(uint(obj.EntityID))
+}
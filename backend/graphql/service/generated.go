@@ -40,6 +40,8 @@ type Config struct {
 
 type ResolverRoot interface {
 	Alliance() AllianceResolver
+	BattleReport() BattleReportResolver
+	BattleSide() BattleSideResolver
 	Character() CharacterResolver
 	Constellation() ConstellationResolver
 	Corporation() CorporationResolver
@@ -47,30 +49,70 @@ type ResolverRoot interface {
 	KillmailAttacker() KillmailAttackerResolver
 	KillmailItem() KillmailItemResolver
 	KillmailVictim() KillmailVictimResolver
+	Leaderboard() LeaderboardResolver
+	LeaderboardEntry() LeaderboardEntryResolver
 	Mutation() MutationResolver
 	Query() QueryResolver
+	RelatedKillmails() RelatedKillmailsResolver
+	ShipCount() ShipCountResolver
 	SolarSystem() SolarSystemResolver
 	Subscription() SubscriptionResolver
 	Type() TypeResolver
 	TypeGroup() TypeGroupResolver
+	Viewer() ViewerResolver
+	WatchlistEntry() WatchlistEntryResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	Activity struct {
+		Days   func(childComplexity int) int
+		Kills  func(childComplexity int) int
+		Losses func(childComplexity int) int
+	}
+
 	Alliance struct {
 		ID          func(childComplexity int) int
 		MemberCount func(childComplexity int) int
 		Name        func(childComplexity int) int
+		Stats       func(childComplexity int, period *neo.StatPeriod) int
 		Ticker      func(childComplexity int) int
 	}
 
+	BattleReport struct {
+		Constellation func(childComplexity int) int
+		EndTime       func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Killmails     func(childComplexity int) int
+		LocationID    func(childComplexity int) int
+		Scope         func(childComplexity int) int
+		Sides         func(childComplexity int) int
+		StartTime     func(childComplexity int) int
+		System        func(childComplexity int) int
+		TotalValue    func(childComplexity int) int
+	}
+
+	BattleSide struct {
+		AllianceIDs    func(childComplexity int) int
+		Alliances      func(childComplexity int) int
+		CorporationIDs func(childComplexity int) int
+		Corporations   func(childComplexity int) int
+		Efficiency     func(childComplexity int) int
+		ISKKilled      func(childComplexity int) int
+		ISKLost        func(childComplexity int) int
+		Pilots         func(childComplexity int) int
+		ShipsKilled    func(childComplexity int) int
+		ShipsLost      func(childComplexity int) int
+	}
+
 	Character struct {
 		Corporation    func(childComplexity int) int
 		ID             func(childComplexity int) int
 		Name           func(childComplexity int) int
 		SecurityStatus func(childComplexity int) int
+		Stats          func(childComplexity int, period *neo.StatPeriod) int
 	}
 
 	Constellation struct {
@@ -86,9 +128,22 @@ type ComplexityRoot struct {
 		ID          func(childComplexity int) int
 		MemberCount func(childComplexity int) int
 		Name        func(childComplexity int) int
+		Stats       func(childComplexity int, period *neo.StatPeriod) int
 		Ticker      func(childComplexity int) int
 	}
 
+	EntityStats struct {
+		AverageGangSize func(childComplexity int) int
+		DangerRatio     func(childComplexity int) int
+		ISKDestroyed    func(childComplexity int) int
+		ISKEfficiency   func(childComplexity int) int
+		ISKLost         func(childComplexity int) int
+		Kills           func(childComplexity int) int
+		Losses          func(childComplexity int) int
+		Period          func(childComplexity int) int
+		SoloKills       func(childComplexity int) int
+	}
+
 	Killmail struct {
 		Attackers      func(childComplexity int, finalBlowOnly *bool) int
 		DestroyedValue func(childComplexity int) int
@@ -101,8 +156,10 @@ type ComplexityRoot struct {
 		IsSolo         func(childComplexity int) int
 		KillmailTime   func(childComplexity int) int
 		MoonID         func(childComplexity int) int
+		Related        func(childComplexity int, windowMinutes *int, radius *neo.RelatedRadius) int
 		SolarSystemID  func(childComplexity int) int
 		System         func(childComplexity int) int
+		Tags           func(childComplexity int) int
 		TotalValue     func(childComplexity int) int
 		Victim         func(childComplexity int) int
 		WarID          func(childComplexity int) int
@@ -126,6 +183,17 @@ type ComplexityRoot struct {
 		WeaponTypeID   func(childComplexity int) int
 	}
 
+	KillmailConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	KillmailEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	KillmailItem struct {
 		Flag              func(childComplexity int) int
 		IsParent          func(childComplexity int) int
@@ -159,8 +227,38 @@ type ComplexityRoot struct {
 		ShipValue     func(childComplexity int) int
 	}
 
+	Leaderboard struct {
+		Category    func(childComplexity int) int
+		Entries     func(childComplexity int) int
+		GeneratedAt func(childComplexity int) int
+		Metric      func(childComplexity int) int
+		Period      func(childComplexity int) int
+		Scope       func(childComplexity int) int
+		ScopeID     func(childComplexity int) int
+	}
+
+	LeaderboardEntry struct {
+		Alliance     func(childComplexity int) int
+		Character    func(childComplexity int) int
+		Corporation  func(childComplexity int) int
+		ID           func(childComplexity int) int
+		ISKDestroyed func(childComplexity int) int
+		Kills        func(childComplexity int) int
+		SolarSystem  func(childComplexity int) int
+		Type         func(childComplexity int) int
+	}
+
 	Mutation struct {
-		MutationPlaceholder func(childComplexity int) int
+		Logout  func(childComplexity int) int
+		Unwatch func(childComplexity int, entity neo.WatchEntity, id int) int
+		Watch   func(childComplexity int, entity neo.WatchEntity, id int) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
 	}
 
 	Position struct {
@@ -170,7 +268,10 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
+		Activity                       func(childComplexity int, entity models.Entity, id int, days *int) int
 		AllianceByAllianceID           func(childComplexity int, id int) int
+		BattleReport                   func(childComplexity int, id string) int
+		BattleReports                  func(childComplexity int, filter models.BattleReportFilter) int
 		CategoryByGroupID              func(childComplexity int, id int) int
 		CharacterByCharacterID         func(childComplexity int, id int) int
 		ConstellationByConstellationID func(childComplexity int, id int) int
@@ -178,12 +279,16 @@ type ComplexityRoot struct {
 		GroupByGroupID                 func(childComplexity int, id int) int
 		Killmail                       func(childComplexity int, id int) int
 		KillmailRecent                 func(childComplexity int, page *int) int
-		KillmailsByEntityID            func(childComplexity int, entity models.Entity, id int, page *int, filter *models.KillmailFilter) int
+		KillmailsByEntityID            func(childComplexity int, entity models.Entity, id int, first *int, after *string, last *int, before *string, filter *models.KillmailFilter) int
+		Leaderboard                    func(childComplexity int, category models.Entity, scope *models.Entity, scopeID *int, period *neo.LeaderboardPeriod, metric *neo.LeaderboardMetric, limit *int) int
+		Me                             func(childComplexity int) int
 		MvByEntityID                   func(childComplexity int, category *models.Category, entity *models.Entity, id *int, age *int, limit *int) int
 		QueryPlaceholder               func(childComplexity int) int
 		RegionByRegionID               func(childComplexity int, id int) int
 		SolarSystemBySolarSystemID     func(childComplexity int, id int) int
+		TopShips                       func(childComplexity int, entity models.Entity, id int, usage neo.ShipUsage, grouping *neo.ShipGrouping, period *neo.StatPeriod, limit *int, filter *models.KillmailFilter) int
 		TypeByTypeID                   func(childComplexity int, id int) int
+		WatchlistKillmails             func(childComplexity int, first *int, after *string, last *int, before *string) int
 	}
 
 	Region struct {
@@ -191,6 +296,22 @@ type ComplexityRoot struct {
 		Name func(childComplexity int) int
 	}
 
+	RelatedKillmails struct {
+		Alliance   func(childComplexity int) int
+		AllianceID func(childComplexity int) int
+		Killmails  func(childComplexity int) int
+		TotalValue func(childComplexity int) int
+	}
+
+	ShipCount struct {
+		Group      func(childComplexity int) int
+		Grouping   func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Killmails  func(childComplexity int) int
+		TotalValue func(childComplexity int) int
+		Type       func(childComplexity int) int
+	}
+
 	SolarSystem struct {
 		Constellation   func(childComplexity int) int
 		ConstellationID func(childComplexity int) int
@@ -203,7 +324,7 @@ type ComplexityRoot struct {
 	}
 
 	Subscription struct {
-		KillmailFeed func(childComplexity int) int
+		KillmailFeed func(childComplexity int, filter *models.KillmailFeedFilter) int
 	}
 
 	Type struct {
@@ -242,23 +363,59 @@ type ComplexityRoot struct {
 		Name       func(childComplexity int) int
 		Published  func(childComplexity int) int
 	}
+
+	Viewer struct {
+		Alliance    func(childComplexity int) int
+		Character   func(childComplexity int) int
+		CharacterID func(childComplexity int) int
+		Characters  func(childComplexity int) int
+		Corporation func(childComplexity int) int
+		Watchlist   func(childComplexity int) int
+	}
+
+	WatchlistEntry struct {
+		Alliance    func(childComplexity int) int
+		Character   func(childComplexity int) int
+		Corporation func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		Entity      func(childComplexity int) int
+		EntityID    func(childComplexity int) int
+		Ship        func(childComplexity int) int
+		System      func(childComplexity int) int
+	}
 }
 
 type AllianceResolver interface {
 	MemberCount(ctx context.Context, obj *neo.Alliance) (int, error)
+	Stats(ctx context.Context, obj *neo.Alliance, period *neo.StatPeriod) (*neo.EntityStats, error)
+}
+type BattleReportResolver interface {
+	System(ctx context.Context, obj *neo.BattleReport) (*neo.SolarSystem, error)
+	Constellation(ctx context.Context, obj *neo.BattleReport) (*neo.Constellation, error)
+}
+type BattleSideResolver interface {
+	Pilots(ctx context.Context, obj *neo.BattleSide) (int, error)
+
+	Alliances(ctx context.Context, obj *neo.BattleSide) ([]*neo.Alliance, error)
+	Corporations(ctx context.Context, obj *neo.BattleSide) ([]*neo.Corporation, error)
 }
 type CharacterResolver interface {
 	Corporation(ctx context.Context, obj *neo.Character) (*neo.Corporation, error)
+	Stats(ctx context.Context, obj *neo.Character, period *neo.StatPeriod) (*neo.EntityStats, error)
 }
 type ConstellationResolver interface {
 	Region(ctx context.Context, obj *neo.Constellation) (*neo.Region, error)
 }
 type CorporationResolver interface {
 	Alliance(ctx context.Context, obj *neo.Corporation) (*neo.Alliance, error)
+	Stats(ctx context.Context, obj *neo.Corporation, period *neo.StatPeriod) (*neo.EntityStats, error)
 }
 type KillmailResolver interface {
+	Tags(ctx context.Context, obj *neo.Killmail) ([]neo.KillmailTag, error)
 	System(ctx context.Context, obj *neo.Killmail) (*neo.SolarSystem, error)
 	Attackers(ctx context.Context, obj *neo.Killmail, finalBlowOnly *bool) ([]*neo.KillmailAttacker, error)
+
+	Related(ctx context.Context, obj *neo.Killmail, windowMinutes *int, radius *neo.RelatedRadius) ([]*neo.RelatedKillmails, error)
 }
 type KillmailAttackerResolver interface {
 	Alliance(ctx context.Context, obj *neo.KillmailAttacker) (*neo.Alliance, error)
@@ -280,30 +437,57 @@ type KillmailVictimResolver interface {
 	Items(ctx context.Context, obj *neo.KillmailVictim) ([]*neo.KillmailItem, error)
 	Fitted(ctx context.Context, obj *neo.KillmailVictim) ([]*neo.KillmailItem, error)
 }
+type LeaderboardResolver interface {
+	Category(ctx context.Context, obj *neo.Leaderboard) (models.Entity, error)
+	Scope(ctx context.Context, obj *neo.Leaderboard) (models.Entity, error)
+}
+type LeaderboardEntryResolver interface {
+	Character(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.Character, error)
+	Corporation(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.Corporation, error)
+	Alliance(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.Alliance, error)
+	Type(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.Type, error)
+	SolarSystem(ctx context.Context, obj *neo.LeaderboardEntry) (*neo.SolarSystem, error)
+}
 type MutationResolver interface {
-	MutationPlaceholder(ctx context.Context) (bool, error)
+	Logout(ctx context.Context) (bool, error)
+	Watch(ctx context.Context, entity neo.WatchEntity, id int) (*neo.WatchlistEntry, error)
+	Unwatch(ctx context.Context, entity neo.WatchEntity, id int) (bool, error)
 }
 type QueryResolver interface {
 	QueryPlaceholder(ctx context.Context) (bool, error)
 	AllianceByAllianceID(ctx context.Context, id int) (*neo.Alliance, error)
+	BattleReports(ctx context.Context, filter models.BattleReportFilter) ([]*neo.BattleReport, error)
+	BattleReport(ctx context.Context, id string) (*neo.BattleReport, error)
 	CharacterByCharacterID(ctx context.Context, id int) (*neo.Character, error)
 	CorporationByCorporationID(ctx context.Context, id int) (*neo.Corporation, error)
 	Killmail(ctx context.Context, id int) (*neo.Killmail, error)
 	KillmailRecent(ctx context.Context, page *int) ([]*neo.Killmail, error)
 	MvByEntityID(ctx context.Context, category *models.Category, entity *models.Entity, id *int, age *int, limit *int) ([]*neo.Killmail, error)
-	KillmailsByEntityID(ctx context.Context, entity models.Entity, id int, page *int, filter *models.KillmailFilter) ([]*neo.Killmail, error)
+	KillmailsByEntityID(ctx context.Context, entity models.Entity, id int, first *int, after *string, last *int, before *string, filter *models.KillmailFilter) (*neo.KillmailConnection, error)
+	Leaderboard(ctx context.Context, category models.Entity, scope *models.Entity, scopeID *int, period *neo.LeaderboardPeriod, metric *neo.LeaderboardMetric, limit *int) (*neo.Leaderboard, error)
+	TopShips(ctx context.Context, entity models.Entity, id int, usage neo.ShipUsage, grouping *neo.ShipGrouping, period *neo.StatPeriod, limit *int, filter *models.KillmailFilter) ([]*neo.ShipCount, error)
+	Activity(ctx context.Context, entity models.Entity, id int, days *int) (*neo.Activity, error)
 	TypeByTypeID(ctx context.Context, id int) (*neo.Type, error)
 	GroupByGroupID(ctx context.Context, id int) (*neo.TypeGroup, error)
 	CategoryByGroupID(ctx context.Context, id int) (*neo.TypeCategory, error)
 	SolarSystemBySolarSystemID(ctx context.Context, id int) (*neo.SolarSystem, error)
 	ConstellationByConstellationID(ctx context.Context, id int) (*neo.Constellation, error)
 	RegionByRegionID(ctx context.Context, id int) (*neo.Region, error)
+	Me(ctx context.Context) (*neo.Viewer, error)
+	WatchlistKillmails(ctx context.Context, first *int, after *string, last *int, before *string) (*neo.KillmailConnection, error)
+}
+type RelatedKillmailsResolver interface {
+	Alliance(ctx context.Context, obj *neo.RelatedKillmails) (*neo.Alliance, error)
+}
+type ShipCountResolver interface {
+	Type(ctx context.Context, obj *neo.ShipCount) (*neo.Type, error)
+	Group(ctx context.Context, obj *neo.ShipCount) (*neo.TypeGroup, error)
 }
 type SolarSystemResolver interface {
 	Constellation(ctx context.Context, obj *neo.SolarSystem) (*neo.Constellation, error)
 }
 type SubscriptionResolver interface {
-	KillmailFeed(ctx context.Context) (<-chan *neo.Killmail, error)
+	KillmailFeed(ctx context.Context, filter *models.KillmailFeedFilter) (<-chan *neo.Killmail, error)
 }
 type TypeResolver interface {
 	Group(ctx context.Context, obj *neo.Type) (*neo.TypeGroup, error)
@@ -312,6 +496,20 @@ type TypeResolver interface {
 type TypeGroupResolver interface {
 	Category(ctx context.Context, obj *neo.TypeGroup) (*neo.TypeCategory, error)
 }
+type ViewerResolver interface {
+	Character(ctx context.Context, obj *neo.Viewer) (*neo.Character, error)
+	Corporation(ctx context.Context, obj *neo.Viewer) (*neo.Corporation, error)
+	Alliance(ctx context.Context, obj *neo.Viewer) (*neo.Alliance, error)
+	Characters(ctx context.Context, obj *neo.Viewer) ([]*neo.Character, error)
+	Watchlist(ctx context.Context, obj *neo.Viewer) ([]*neo.WatchlistEntry, error)
+}
+type WatchlistEntryResolver interface {
+	Character(ctx context.Context, obj *neo.WatchlistEntry) (*neo.Character, error)
+	Corporation(ctx context.Context, obj *neo.WatchlistEntry) (*neo.Corporation, error)
+	Alliance(ctx context.Context, obj *neo.WatchlistEntry) (*neo.Alliance, error)
+	System(ctx context.Context, obj *neo.WatchlistEntry) (*neo.SolarSystem, error)
+	Ship(ctx context.Context, obj *neo.WatchlistEntry) (*neo.Type, error)
+}
 
 type executableSchema struct {
 	resolvers  ResolverRoot
@@ -328,6 +526,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "Activity.days":
+		if e.complexity.Activity.Days == nil {
+			break
+		}
+
+		return e.complexity.Activity.Days(childComplexity), true
+
+	case "Activity.kills":
+		if e.complexity.Activity.Kills == nil {
+			break
+		}
+
+		return e.complexity.Activity.Kills(childComplexity), true
+
+	case "Activity.losses":
+		if e.complexity.Activity.Losses == nil {
+			break
+		}
+
+		return e.complexity.Activity.Losses(childComplexity), true
+
 	case "Alliance.id":
 		if e.complexity.Alliance.ID == nil {
 			break
@@ -349,6 +568,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Alliance.Name(childComplexity), true
 
+	case "Alliance.stats":
+		if e.complexity.Alliance.Stats == nil {
+			break
+		}
+
+		args, err := ec.field_Alliance_stats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Alliance.Stats(childComplexity, args["period"].(*neo.StatPeriod)), true
+
 	case "Alliance.ticker":
 		if e.complexity.Alliance.Ticker == nil {
 			break
@@ -356,6 +587,146 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Alliance.Ticker(childComplexity), true
 
+	case "BattleReport.constellation":
+		if e.complexity.BattleReport.Constellation == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.Constellation(childComplexity), true
+
+	case "BattleReport.endTime":
+		if e.complexity.BattleReport.EndTime == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.EndTime(childComplexity), true
+
+	case "BattleReport.id":
+		if e.complexity.BattleReport.ID == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.ID(childComplexity), true
+
+	case "BattleReport.killmails":
+		if e.complexity.BattleReport.Killmails == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.Killmails(childComplexity), true
+
+	case "BattleReport.locationID":
+		if e.complexity.BattleReport.LocationID == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.LocationID(childComplexity), true
+
+	case "BattleReport.scope":
+		if e.complexity.BattleReport.Scope == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.Scope(childComplexity), true
+
+	case "BattleReport.sides":
+		if e.complexity.BattleReport.Sides == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.Sides(childComplexity), true
+
+	case "BattleReport.startTime":
+		if e.complexity.BattleReport.StartTime == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.StartTime(childComplexity), true
+
+	case "BattleReport.system":
+		if e.complexity.BattleReport.System == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.System(childComplexity), true
+
+	case "BattleReport.totalValue":
+		if e.complexity.BattleReport.TotalValue == nil {
+			break
+		}
+
+		return e.complexity.BattleReport.TotalValue(childComplexity), true
+
+	case "BattleSide.allianceIDs":
+		if e.complexity.BattleSide.AllianceIDs == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.AllianceIDs(childComplexity), true
+
+	case "BattleSide.alliances":
+		if e.complexity.BattleSide.Alliances == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.Alliances(childComplexity), true
+
+	case "BattleSide.corporationIDs":
+		if e.complexity.BattleSide.CorporationIDs == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.CorporationIDs(childComplexity), true
+
+	case "BattleSide.corporations":
+		if e.complexity.BattleSide.Corporations == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.Corporations(childComplexity), true
+
+	case "BattleSide.efficiency":
+		if e.complexity.BattleSide.Efficiency == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.Efficiency(childComplexity), true
+
+	case "BattleSide.iskKilled":
+		if e.complexity.BattleSide.ISKKilled == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.ISKKilled(childComplexity), true
+
+	case "BattleSide.iskLost":
+		if e.complexity.BattleSide.ISKLost == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.ISKLost(childComplexity), true
+
+	case "BattleSide.pilots":
+		if e.complexity.BattleSide.Pilots == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.Pilots(childComplexity), true
+
+	case "BattleSide.shipsKilled":
+		if e.complexity.BattleSide.ShipsKilled == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.ShipsKilled(childComplexity), true
+
+	case "BattleSide.shipsLost":
+		if e.complexity.BattleSide.ShipsLost == nil {
+			break
+		}
+
+		return e.complexity.BattleSide.ShipsLost(childComplexity), true
+
 	case "Character.corporation":
 		if e.complexity.Character.Corporation == nil {
 			break
@@ -384,6 +755,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Character.SecurityStatus(childComplexity), true
 
+	case "Character.stats":
+		if e.complexity.Character.Stats == nil {
+			break
+		}
+
+		args, err := ec.field_Character_stats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Character.Stats(childComplexity, args["period"].(*neo.StatPeriod)), true
+
 	case "Constellation.factionID":
 		if e.complexity.Constellation.FactionID == nil {
 			break
@@ -447,6 +830,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Corporation.Name(childComplexity), true
 
+	case "Corporation.stats":
+		if e.complexity.Corporation.Stats == nil {
+			break
+		}
+
+		args, err := ec.field_Corporation_stats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Corporation.Stats(childComplexity, args["period"].(*neo.StatPeriod)), true
+
 	case "Corporation.ticker":
 		if e.complexity.Corporation.Ticker == nil {
 			break
@@ -454,6 +849,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Corporation.Ticker(childComplexity), true
 
+	case "EntityStats.averageGangSize":
+		if e.complexity.EntityStats.AverageGangSize == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.AverageGangSize(childComplexity), true
+
+	case "EntityStats.dangerRatio":
+		if e.complexity.EntityStats.DangerRatio == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.DangerRatio(childComplexity), true
+
+	case "EntityStats.iskDestroyed":
+		if e.complexity.EntityStats.ISKDestroyed == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.ISKDestroyed(childComplexity), true
+
+	case "EntityStats.iskEfficiency":
+		if e.complexity.EntityStats.ISKEfficiency == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.ISKEfficiency(childComplexity), true
+
+	case "EntityStats.iskLost":
+		if e.complexity.EntityStats.ISKLost == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.ISKLost(childComplexity), true
+
+	case "EntityStats.kills":
+		if e.complexity.EntityStats.Kills == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.Kills(childComplexity), true
+
+	case "EntityStats.losses":
+		if e.complexity.EntityStats.Losses == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.Losses(childComplexity), true
+
+	case "EntityStats.period":
+		if e.complexity.EntityStats.Period == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.Period(childComplexity), true
+
+	case "EntityStats.soloKills":
+		if e.complexity.EntityStats.SoloKills == nil {
+			break
+		}
+
+		return e.complexity.EntityStats.SoloKills(childComplexity), true
+
 	case "Killmail.attackers":
 		if e.complexity.Killmail.Attackers == nil {
 			break
@@ -536,6 +994,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Killmail.MoonID(childComplexity), true
 
+	case "Killmail.related":
+		if e.complexity.Killmail.Related == nil {
+			break
+		}
+
+		args, err := ec.field_Killmail_related_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Killmail.Related(childComplexity, args["windowMinutes"].(*int), args["radius"].(*neo.RelatedRadius)), true
+
 	case "Killmail.solarSystemID":
 		if e.complexity.Killmail.SolarSystemID == nil {
 			break
@@ -550,6 +1020,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Killmail.System(childComplexity), true
 
+	case "Killmail.tags":
+		if e.complexity.Killmail.Tags == nil {
+			break
+		}
+
+		return e.complexity.Killmail.Tags(childComplexity), true
+
 	case "Killmail.totalValue":
 		if e.complexity.Killmail.TotalValue == nil {
 			break
@@ -676,6 +1153,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.KillmailAttacker.WeaponTypeID(childComplexity), true
 
+	case "KillmailConnection.edges":
+		if e.complexity.KillmailConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.KillmailConnection.Edges(childComplexity), true
+
+	case "KillmailConnection.pageInfo":
+		if e.complexity.KillmailConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.KillmailConnection.PageInfo(childComplexity), true
+
+	case "KillmailConnection.totalCount":
+		if e.complexity.KillmailConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.KillmailConnection.TotalCount(childComplexity), true
+
+	case "KillmailEdge.cursor":
+		if e.complexity.KillmailEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.KillmailEdge.Cursor(childComplexity), true
+
+	case "KillmailEdge.node":
+		if e.complexity.KillmailEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.KillmailEdge.Node(childComplexity), true
+
 	case "KillmailItem.flag":
 		if e.complexity.KillmailItem.Flag == nil {
 			break
@@ -865,71 +1377,264 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.KillmailVictim.ShipValue(childComplexity), true
 
-	case "Mutation.mutationPlaceholder":
-		if e.complexity.Mutation.MutationPlaceholder == nil {
+	case "Leaderboard.category":
+		if e.complexity.Leaderboard.Category == nil {
 			break
 		}
 
-		return e.complexity.Mutation.MutationPlaceholder(childComplexity), true
+		return e.complexity.Leaderboard.Category(childComplexity), true
 
-	case "Position.x":
-		if e.complexity.Position.X == nil {
+	case "Leaderboard.entries":
+		if e.complexity.Leaderboard.Entries == nil {
 			break
 		}
 
-		return e.complexity.Position.X(childComplexity), true
+		return e.complexity.Leaderboard.Entries(childComplexity), true
 
-	case "Position.y":
-		if e.complexity.Position.Y == nil {
+	case "Leaderboard.generatedAt":
+		if e.complexity.Leaderboard.GeneratedAt == nil {
 			break
 		}
 
-		return e.complexity.Position.Y(childComplexity), true
+		return e.complexity.Leaderboard.GeneratedAt(childComplexity), true
 
-	case "Position.z":
-		if e.complexity.Position.Z == nil {
+	case "Leaderboard.metric":
+		if e.complexity.Leaderboard.Metric == nil {
 			break
 		}
 
-		return e.complexity.Position.Z(childComplexity), true
+		return e.complexity.Leaderboard.Metric(childComplexity), true
 
-	case "Query.allianceByAllianceID":
-		if e.complexity.Query.AllianceByAllianceID == nil {
+	case "Leaderboard.period":
+		if e.complexity.Leaderboard.Period == nil {
 			break
 		}
 
-		args, err := ec.field_Query_allianceByAllianceID_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Leaderboard.Period(childComplexity), true
+
+	case "Leaderboard.scope":
+		if e.complexity.Leaderboard.Scope == nil {
+			break
 		}
 
-		return e.complexity.Query.AllianceByAllianceID(childComplexity, args["id"].(int)), true
+		return e.complexity.Leaderboard.Scope(childComplexity), true
 
-	case "Query.categoryByGroupID":
-		if e.complexity.Query.CategoryByGroupID == nil {
+	case "Leaderboard.scopeID":
+		if e.complexity.Leaderboard.ScopeID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_categoryByGroupID_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Leaderboard.ScopeID(childComplexity), true
+
+	case "LeaderboardEntry.alliance":
+		if e.complexity.LeaderboardEntry.Alliance == nil {
+			break
 		}
 
-		return e.complexity.Query.CategoryByGroupID(childComplexity, args["id"].(int)), true
+		return e.complexity.LeaderboardEntry.Alliance(childComplexity), true
 
-	case "Query.characterByCharacterID":
-		if e.complexity.Query.CharacterByCharacterID == nil {
+	case "LeaderboardEntry.character":
+		if e.complexity.LeaderboardEntry.Character == nil {
 			break
 		}
 
-		args, err := ec.field_Query_characterByCharacterID_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.LeaderboardEntry.Character(childComplexity), true
+
+	case "LeaderboardEntry.corporation":
+		if e.complexity.LeaderboardEntry.Corporation == nil {
+			break
 		}
 
-		return e.complexity.Query.CharacterByCharacterID(childComplexity, args["id"].(int)), true
+		return e.complexity.LeaderboardEntry.Corporation(childComplexity), true
 
-	case "Query.constellationByConstellationID":
+	case "LeaderboardEntry.id":
+		if e.complexity.LeaderboardEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.LeaderboardEntry.ID(childComplexity), true
+
+	case "LeaderboardEntry.iskDestroyed":
+		if e.complexity.LeaderboardEntry.ISKDestroyed == nil {
+			break
+		}
+
+		return e.complexity.LeaderboardEntry.ISKDestroyed(childComplexity), true
+
+	case "LeaderboardEntry.kills":
+		if e.complexity.LeaderboardEntry.Kills == nil {
+			break
+		}
+
+		return e.complexity.LeaderboardEntry.Kills(childComplexity), true
+
+	case "LeaderboardEntry.solarSystem":
+		if e.complexity.LeaderboardEntry.SolarSystem == nil {
+			break
+		}
+
+		return e.complexity.LeaderboardEntry.SolarSystem(childComplexity), true
+
+	case "LeaderboardEntry.type":
+		if e.complexity.LeaderboardEntry.Type == nil {
+			break
+		}
+
+		return e.complexity.LeaderboardEntry.Type(childComplexity), true
+
+	case "Mutation.logout":
+		if e.complexity.Mutation.Logout == nil {
+			break
+		}
+
+		return e.complexity.Mutation.Logout(childComplexity), true
+
+	case "Mutation.unwatch":
+		if e.complexity.Mutation.Unwatch == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unwatch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Unwatch(childComplexity, args["entity"].(neo.WatchEntity), args["id"].(int)), true
+
+	case "Mutation.watch":
+		if e.complexity.Mutation.Watch == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_watch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Watch(childComplexity, args["entity"].(neo.WatchEntity), args["id"].(int)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "Position.x":
+		if e.complexity.Position.X == nil {
+			break
+		}
+
+		return e.complexity.Position.X(childComplexity), true
+
+	case "Position.y":
+		if e.complexity.Position.Y == nil {
+			break
+		}
+
+		return e.complexity.Position.Y(childComplexity), true
+
+	case "Position.z":
+		if e.complexity.Position.Z == nil {
+			break
+		}
+
+		return e.complexity.Position.Z(childComplexity), true
+
+	case "Query.activity":
+		if e.complexity.Query.Activity == nil {
+			break
+		}
+
+		args, err := ec.field_Query_activity_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Activity(childComplexity, args["entity"].(models.Entity), args["id"].(int), args["days"].(*int)), true
+
+	case "Query.allianceByAllianceID":
+		if e.complexity.Query.AllianceByAllianceID == nil {
+			break
+		}
+
+		args, err := ec.field_Query_allianceByAllianceID_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AllianceByAllianceID(childComplexity, args["id"].(int)), true
+
+	case "Query.battleReport":
+		if e.complexity.Query.BattleReport == nil {
+			break
+		}
+
+		args, err := ec.field_Query_battleReport_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BattleReport(childComplexity, args["id"].(string)), true
+
+	case "Query.battleReports":
+		if e.complexity.Query.BattleReports == nil {
+			break
+		}
+
+		args, err := ec.field_Query_battleReports_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.BattleReports(childComplexity, args["filter"].(models.BattleReportFilter)), true
+
+	case "Query.categoryByGroupID":
+		if e.complexity.Query.CategoryByGroupID == nil {
+			break
+		}
+
+		args, err := ec.field_Query_categoryByGroupID_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CategoryByGroupID(childComplexity, args["id"].(int)), true
+
+	case "Query.characterByCharacterID":
+		if e.complexity.Query.CharacterByCharacterID == nil {
+			break
+		}
+
+		args, err := ec.field_Query_characterByCharacterID_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CharacterByCharacterID(childComplexity, args["id"].(int)), true
+
+	case "Query.constellationByConstellationID":
 		if e.complexity.Query.ConstellationByConstellationID == nil {
 			break
 		}
@@ -999,7 +1704,26 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.KillmailsByEntityID(childComplexity, args["entity"].(models.Entity), args["id"].(int), args["page"].(*int), args["filter"].(*models.KillmailFilter)), true
+		return e.complexity.Query.KillmailsByEntityID(childComplexity, args["entity"].(models.Entity), args["id"].(int), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string), args["filter"].(*models.KillmailFilter)), true
+
+	case "Query.leaderboard":
+		if e.complexity.Query.Leaderboard == nil {
+			break
+		}
+
+		args, err := ec.field_Query_leaderboard_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Leaderboard(childComplexity, args["category"].(models.Entity), args["scope"].(*models.Entity), args["scopeID"].(*int), args["period"].(*neo.LeaderboardPeriod), args["metric"].(*neo.LeaderboardMetric), args["limit"].(*int)), true
+
+	case "Query.me":
+		if e.complexity.Query.Me == nil {
+			break
+		}
+
+		return e.complexity.Query.Me(childComplexity), true
 
 	case "Query.mvByEntityID":
 		if e.complexity.Query.MvByEntityID == nil {
@@ -1044,6 +1768,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.SolarSystemBySolarSystemID(childComplexity, args["id"].(int)), true
 
+	case "Query.topShips":
+		if e.complexity.Query.TopShips == nil {
+			break
+		}
+
+		args, err := ec.field_Query_topShips_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TopShips(childComplexity, args["entity"].(models.Entity), args["id"].(int), args["usage"].(neo.ShipUsage), args["grouping"].(*neo.ShipGrouping), args["period"].(*neo.StatPeriod), args["limit"].(*int), args["filter"].(*models.KillmailFilter)), true
+
 	case "Query.typeByTypeID":
 		if e.complexity.Query.TypeByTypeID == nil {
 			break
@@ -1056,6 +1792,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.TypeByTypeID(childComplexity, args["id"].(int)), true
 
+	case "Query.watchlistKillmails":
+		if e.complexity.Query.WatchlistKillmails == nil {
+			break
+		}
+
+		args, err := ec.field_Query_watchlistKillmails_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.WatchlistKillmails(childComplexity, args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string)), true
+
 	case "Region.id":
 		if e.complexity.Region.ID == nil {
 			break
@@ -1070,6 +1818,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Region.Name(childComplexity), true
 
+	case "RelatedKillmails.alliance":
+		if e.complexity.RelatedKillmails.Alliance == nil {
+			break
+		}
+
+		return e.complexity.RelatedKillmails.Alliance(childComplexity), true
+
+	case "RelatedKillmails.allianceID":
+		if e.complexity.RelatedKillmails.AllianceID == nil {
+			break
+		}
+
+		return e.complexity.RelatedKillmails.AllianceID(childComplexity), true
+
+	case "RelatedKillmails.killmails":
+		if e.complexity.RelatedKillmails.Killmails == nil {
+			break
+		}
+
+		return e.complexity.RelatedKillmails.Killmails(childComplexity), true
+
+	case "RelatedKillmails.totalValue":
+		if e.complexity.RelatedKillmails.TotalValue == nil {
+			break
+		}
+
+		return e.complexity.RelatedKillmails.TotalValue(childComplexity), true
+
+	case "ShipCount.group":
+		if e.complexity.ShipCount.Group == nil {
+			break
+		}
+
+		return e.complexity.ShipCount.Group(childComplexity), true
+
+	case "ShipCount.grouping":
+		if e.complexity.ShipCount.Grouping == nil {
+			break
+		}
+
+		return e.complexity.ShipCount.Grouping(childComplexity), true
+
+	case "ShipCount.id":
+		if e.complexity.ShipCount.ID == nil {
+			break
+		}
+
+		return e.complexity.ShipCount.ID(childComplexity), true
+
+	case "ShipCount.killmails":
+		if e.complexity.ShipCount.Killmails == nil {
+			break
+		}
+
+		return e.complexity.ShipCount.Killmails(childComplexity), true
+
+	case "ShipCount.totalValue":
+		if e.complexity.ShipCount.TotalValue == nil {
+			break
+		}
+
+		return e.complexity.ShipCount.TotalValue(childComplexity), true
+
+	case "ShipCount.type":
+		if e.complexity.ShipCount.Type == nil {
+			break
+		}
+
+		return e.complexity.ShipCount.Type(childComplexity), true
+
 	case "SolarSystem.constellation":
 		if e.complexity.SolarSystem.Constellation == nil {
 			break
@@ -1131,7 +1949,12 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			break
 		}
 
-		return e.complexity.Subscription.KillmailFeed(childComplexity), true
+		args, err := ec.field_Subscription_killmailFeed_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.KillmailFeed(childComplexity, args["filter"].(*models.KillmailFeedFilter)), true
 
 	case "Type.attributes":
 		if e.complexity.Type.Attributes == nil {
@@ -1287,51 +2110,149 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TypeGroup.Published(childComplexity), true
 
-	}
-	return 0, false
-}
+	case "Viewer.alliance":
+		if e.complexity.Viewer.Alliance == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e}
-	first := true
+		return e.complexity.Viewer.Alliance(childComplexity), true
 
-	switch rc.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			data := ec._Query(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
+	case "Viewer.character":
+		if e.complexity.Viewer.Character == nil {
+			break
+		}
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.Viewer.Character(childComplexity), true
+
+	case "Viewer.characterID":
+		if e.complexity.Viewer.CharacterID == nil {
+			break
 		}
-	case ast.Mutation:
-		return func(ctx context.Context) *graphql.Response {
-			if !first {
-				return nil
-			}
-			first = false
-			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
 
-			return &graphql.Response{
-				Data: buf.Bytes(),
-			}
+		return e.complexity.Viewer.CharacterID(childComplexity), true
+
+	case "Viewer.characters":
+		if e.complexity.Viewer.Characters == nil {
+			break
 		}
-	case ast.Subscription:
-		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
 
-		var buf bytes.Buffer
-		return func(ctx context.Context) *graphql.Response {
-			buf.Reset()
-			data := next()
+		return e.complexity.Viewer.Characters(childComplexity), true
+
+	case "Viewer.corporation":
+		if e.complexity.Viewer.Corporation == nil {
+			break
+		}
+
+		return e.complexity.Viewer.Corporation(childComplexity), true
+
+	case "Viewer.watchlist":
+		if e.complexity.Viewer.Watchlist == nil {
+			break
+		}
+
+		return e.complexity.Viewer.Watchlist(childComplexity), true
+
+	case "WatchlistEntry.alliance":
+		if e.complexity.WatchlistEntry.Alliance == nil {
+			break
+		}
+
+		return e.complexity.WatchlistEntry.Alliance(childComplexity), true
+
+	case "WatchlistEntry.character":
+		if e.complexity.WatchlistEntry.Character == nil {
+			break
+		}
+
+		return e.complexity.WatchlistEntry.Character(childComplexity), true
+
+	case "WatchlistEntry.corporation":
+		if e.complexity.WatchlistEntry.Corporation == nil {
+			break
+		}
+
+		return e.complexity.WatchlistEntry.Corporation(childComplexity), true
+
+	case "WatchlistEntry.createdAt":
+		if e.complexity.WatchlistEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WatchlistEntry.CreatedAt(childComplexity), true
+
+	case "WatchlistEntry.entity":
+		if e.complexity.WatchlistEntry.Entity == nil {
+			break
+		}
+
+		return e.complexity.WatchlistEntry.Entity(childComplexity), true
+
+	case "WatchlistEntry.entityID":
+		if e.complexity.WatchlistEntry.EntityID == nil {
+			break
+		}
+
+		return e.complexity.WatchlistEntry.EntityID(childComplexity), true
+
+	case "WatchlistEntry.ship":
+		if e.complexity.WatchlistEntry.Ship == nil {
+			break
+		}
+
+		return e.complexity.WatchlistEntry.Ship(childComplexity), true
+
+	case "WatchlistEntry.system":
+		if e.complexity.WatchlistEntry.System == nil {
+			break
+		}
+
+		return e.complexity.WatchlistEntry.System(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	ec := executionContext{rc, e}
+	first := true
+
+	switch rc.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			data := ec._Query(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next()
 
 			if data == nil {
 				return nil
@@ -1377,6 +2298,58 @@ type Alliance @goModel(model: "github.com/eveisesi/neo.Alliance") {
     name: String!
     ticker: String!
     memberCount: Int!
+
+    stats(period: StatPeriod = allTime): EntityStats! @goField(forceResolver: true)
+}
+`, BuiltIn: false},
+	{Name: "graphql/schema/battle.graphql", Input: `extend type Query {
+    battleReports(filter: BattleReportFilter!): [BattleReport]!
+    battleReport(id: String!): BattleReport!
+}
+
+input BattleReportFilter {
+    from: Time!
+    to: Time!
+    scope: BattleScope = system
+    solarSystemID: Int
+    constellationID: Int
+    regionID: Int
+    # Longest gap in minutes between two consecutive killmails of the same battle
+    window: Int
+    minKillmails: Int
+}
+
+enum BattleScope @goModel(model: "github.com/eveisesi/neo.BattleScope") {
+    system
+    constellation
+}
+
+type BattleReport @goModel(model: "github.com/eveisesi/neo.BattleReport") {
+    id: String!
+    scope: BattleScope!
+    locationID: Int!
+    startTime: Time!
+    endTime: Time!
+    totalValue: Float!
+    killmails: [Killmail]!
+    sides: [BattleSide]!
+
+    system: SolarSystem @goField(forceResolver: true)
+    constellation: Constellation @goField(forceResolver: true)
+}
+
+type BattleSide @goModel(model: "github.com/eveisesi/neo.BattleSide") {
+    allianceIDs: [Int!]!
+    corporationIDs: [Int!]!
+    pilots: Int! @goField(forceResolver: true)
+    iskKilled: Float! @goField(name: "ISKKilled")
+    iskLost: Float! @goField(name: "ISKLost")
+    shipsKilled: Int!
+    shipsLost: Int!
+    efficiency: Float!
+
+    alliances: [Alliance]! @goField(forceResolver: true)
+    corporations: [Corporation]! @goField(forceResolver: true)
 }
 `, BuiltIn: false},
 	{Name: "graphql/schema/character.graphql", Input: `extend type Query {
@@ -1390,6 +2363,8 @@ type Character @goModel(model: "github.com/eveisesi/neo.Character") {
         @goField(forceResolver: false, name: "security_status")
 
     corporation: Corporation!
+
+    stats(period: StatPeriod = allTime): EntityStats! @goField(forceResolver: true)
 }
 `, BuiltIn: false},
 	{Name: "graphql/schema/corporation.graphql", Input: `extend type Query {
@@ -1403,6 +2378,8 @@ type Corporation @goModel(model: "github.com/eveisesi/neo.Corporation") {
     memberCount: Int!
 
     alliance: Alliance
+
+    stats(period: StatPeriod = allTime): EntityStats! @goField(forceResolver: true)
 }
 `, BuiltIn: false},
 	{Name: "graphql/schema/filters.graphql", Input: `input IntFilterInput {
@@ -1421,6 +2398,11 @@ input BooleanFilterInput {
     ne: Boolean
 }
 
+input TagFilterInput {
+    in: [KillmailTag!]
+    nin: [KillmailTag!]
+}
+
 input TimeFilterInput {
     eq: Time
     ne: Time
@@ -1441,12 +2423,36 @@ input TimeFilterInput {
         age: Int = 7
         limit: Int = 6
     ): [Killmail]!
+    # Killmails the entity was involved in, newest first. Page towards older killmails with first and after, and
+    # back towards newer killmails with last and before
     killmailsByEntityID(
         entity: Entity!
         id: Int!
-        page: Int = 1
+        first: Int
+        after: String
+        last: Int
+        before: String
         filter: KillmailFilter
-    ): [Killmail]!
+    ): KillmailConnection!
+}
+
+type KillmailConnection @goModel(model: "github.com/eveisesi/neo.KillmailConnection") {
+    edges: [KillmailEdge!]!
+    pageInfo: PageInfo!
+    # Number of killmails matching the query across every page
+    totalCount: Int!
+}
+
+type KillmailEdge @goModel(model: "github.com/eveisesi/neo.KillmailEdge") {
+    cursor: String!
+    node: Killmail!
+}
+
+type PageInfo @goModel(model: "github.com/eveisesi/neo.PageInfo") {
+    hasNextPage: Boolean!
+    hasPreviousPage: Boolean!
+    startCursor: String
+    endCursor: String
 }
 
 input KillmailFilter {
@@ -1456,6 +2462,7 @@ input KillmailFilter {
     isNPC: BooleanFilterInput
     isAwox: BooleanFilterInput
     isSolo: BooleanFilterInput
+    tags: TagFilterInput
     droppedValue: IntFilterInput
     destroyedValue: IntFilterInput
     fittedValue: IntFilterInput
@@ -1480,6 +2487,29 @@ input KillmailFilter {
     victimShiptypeID: IntFilterInput
 }
 
+input KillmailFeedFilter {
+    # A killmail matches when any of these entities is involved on the given side, or on either side when no side is set
+    characterIDs: [Int!]
+    corporationIDs: [Int!]
+    allianceIDs: [Int!]
+    side: KillmailSide
+
+    regionIDs: [Int!]
+    solarSystemIDs: [Int!]
+    # Matched against the ship group of the victim
+    shipGroupIDs: [Int!]
+
+    minTotalValue: Float
+    isSolo: Boolean
+    isNPC: Boolean
+    isAwox: Boolean
+}
+
+enum KillmailSide @goModel(model: "github.com/eveisesi/neo.KillmailSide") {
+    attacker
+    victim
+}
+
 enum Category {
     all
     kill
@@ -1498,6 +2528,19 @@ enum Entity {
     region
 }
 
+enum KillmailTag @goModel(model: "github.com/eveisesi/neo.KillmailTag") {
+    awox
+    npc
+    solo
+    highsecGank
+    capital
+    structure
+    pod
+    wormhole
+    smallGang
+    blob
+}
+
 type Killmail @goModel(model: "github.com/eveisesi/neo.Killmail") {
     id: Int!
     hash: String!
@@ -1512,11 +2555,27 @@ type Killmail @goModel(model: "github.com/eveisesi/neo.Killmail") {
     fittedValue: Float!
     totalValue: Float!
     killmailTime: Time!
+    tags: [KillmailTag!]! @goField(forceResolver: true)
 
     system: SolarSystem! @goField(forceResolver: true)
     attackers(finalBlowOnly: Boolean = false): [KillmailAttacker]!
     @goField(forceResolver: true)
     victim: KillmailVictim!
+    related(windowMinutes: Int = 15, radius: RelatedRadius = system): [RelatedKillmails]!
+    @goField(forceResolver: true)
+}
+
+enum RelatedRadius @goModel(model: "github.com/eveisesi/neo.RelatedRadius") {
+    system
+    constellation
+}
+
+type RelatedKillmails @goModel(model: "github.com/eveisesi/neo.RelatedKillmails") {
+    allianceID: Int
+    totalValue: Float!
+    killmails: [Killmail]!
+
+    alliance: Alliance @goField(forceResolver: true)
 }
 
 type KillmailAttacker
@@ -1579,6 +2638,54 @@ type Position @goModel(model: "github.com/eveisesi/neo.Position") {
     y: Float
     z: Float
 }
+`, BuiltIn: false},
+	{Name: "graphql/schema/leaderboard.graphql", Input: `extend type Query {
+    # Top entities of the category by kills or ISK destroyed. Leaderboards are global unless a scope is given, in
+    # which case only the kills of the scope entity, or the kills in the scope location, are counted
+    leaderboard(
+        category: Entity!
+        scope: Entity = all
+        scopeID: Int
+        period: LeaderboardPeriod = last7Days
+        metric: LeaderboardMetric = kills
+        limit: Int = 10
+    ): Leaderboard!
+}
+
+enum LeaderboardPeriod @goModel(model: "github.com/eveisesi/neo.LeaderboardPeriod") {
+    lastDay
+    last7Days
+    last30Days
+    last90Days
+}
+
+enum LeaderboardMetric @goModel(model: "github.com/eveisesi/neo.LeaderboardMetric") {
+    kills
+    iskDestroyed
+}
+
+type Leaderboard @goModel(model: "github.com/eveisesi/neo.Leaderboard") {
+    category: Entity! @goField(forceResolver: true)
+    scope: Entity! @goField(forceResolver: true)
+    scopeID: Int
+    period: LeaderboardPeriod!
+    metric: LeaderboardMetric!
+    entries: [LeaderboardEntry!]!
+    generatedAt: Time!
+}
+
+type LeaderboardEntry @goModel(model: "github.com/eveisesi/neo.LeaderboardEntry") {
+    id: Int!
+    kills: Int!
+    iskDestroyed: Float! @goField(name: "ISKDestroyed")
+
+    # Only the field matching the category of the leaderboard is set
+    character: Character @goField(forceResolver: true)
+    corporation: Corporation @goField(forceResolver: true)
+    alliance: Alliance @goField(forceResolver: true)
+    type: Type @goField(forceResolver: true)
+    solarSystem: SolarSystem @goField(forceResolver: true)
+}
 `, BuiltIn: false},
 	{Name: "graphql/schema/schema.graphql", Input: `directive @goModel(model: String) on OBJECT | INPUT_OBJECT
 
@@ -1589,14 +2696,87 @@ type Query {
 }
 
 type Mutation {
-    mutationPlaceholder: Boolean!
+    # Ends the session the request was made with
+    logout: Boolean!
 }
 
 type Subscription {
-    killmailFeed: Killmail
+    # Killmails as they are imported. Killmails that do not match the filter are not sent to the subscriber
+    killmailFeed(filter: KillmailFeedFilter): Killmail
 }
 
 scalar Time
+`, BuiltIn: false},
+	{Name: "graphql/schema/ships.graphql", Input: `extend type Query {
+    # Ship types or groups the entity flew on its kills, or the victim ships of its kills or losses, largest first
+    topShips(
+        entity: Entity!
+        id: Int!
+        usage: ShipUsage!
+        grouping: ShipGrouping = type
+        period: StatPeriod = last30Days
+        limit: Int = 10
+        filter: KillmailFilter
+    ): [ShipCount]!
+}
+
+enum ShipUsage @goModel(model: "github.com/eveisesi/neo.ShipUsage") {
+    flown
+    killed
+    lost
+}
+
+enum ShipGrouping @goModel(model: "github.com/eveisesi/neo.ShipGrouping") {
+    type
+    group
+}
+
+type ShipCount @goModel(model: "github.com/eveisesi/neo.ShipCount") {
+    id: Int!
+    grouping: ShipGrouping!
+    killmails: Int!
+    totalValue: Float!
+
+    # Only set when ships are grouped by type
+    type: Type @goField(forceResolver: true)
+    group: TypeGroup @goField(forceResolver: true)
+}
+`, BuiltIn: false},
+	{Name: "graphql/schema/stats.graphql", Input: `extend type Query {
+    # Kills and losses of the entity over the last days, bucketed by day of the week and hour of the day in EVE time
+    activity(entity: Entity!, id: Int!, days: Int = 30): Activity!
+}
+
+enum StatPeriod @goModel(model: "github.com/eveisesi/neo.StatPeriod") {
+    today
+    last7Days
+    last30Days
+    thisMonth
+    thisYear
+    allTime
+}
+
+type EntityStats @goModel(model: "github.com/eveisesi/neo.EntityStats") {
+    period: StatPeriod!
+    kills: Int!
+    losses: Int!
+    iskDestroyed: Float! @goField(name: "ISKDestroyed")
+    iskLost: Float! @goField(name: "ISKLost")
+    # Share of the ISK destroyed and lost that was destroyed
+    iskEfficiency: Float! @goField(name: "ISKEfficiency")
+    soloKills: Int!
+    # Share of the ships killed and lost that were killed
+    dangerRatio: Float!
+    # Average number of player attackers on a kill
+    averageGangSize: Float!
+}
+
+type Activity @goModel(model: "github.com/eveisesi/neo.Activity") {
+    days: Int!
+    # Seven rows, one per day of the week starting on Sunday, of 24 hourly counts
+    kills: [[Int!]!]!
+    losses: [[Int!]!]!
+}
 `, BuiltIn: false},
 	{Name: "graphql/schema/universe.graphql", Input: `extend type Query {
     typeByTypeID(id: Int!): Type!
@@ -1671,6 +2851,55 @@ type TypeGroup @goModel(model: "github.com/eveisesi/neo.TypeGroup") {
 
     category: TypeCategory!
 }
+`, BuiltIn: false},
+	{Name: "graphql/schema/viewer.graphql", Input: `extend type Query {
+    # The signed in character, or null for anonymous requests
+    me: Viewer
+}
+
+type Viewer @goModel(model: "github.com/eveisesi/neo.Viewer") {
+    characterID: Int!
+
+    character: Character! @goField(forceResolver: true)
+    corporation: Corporation! @goField(forceResolver: true)
+    alliance: Alliance @goField(forceResolver: true)
+    # Every character linked to the viewer's account, main first
+    characters: [Character]! @goField(forceResolver: true)
+    # Entities followed by the viewer's account
+    watchlist: [WatchlistEntry]! @goField(forceResolver: true)
+}
+`, BuiltIn: false},
+	{Name: "graphql/schema/watchlist.graphql", Input: `extend type Query {
+    # Killmails involving any entity on the viewer's watchlist, newest first. Pages the same way as killmailsByEntityID
+    watchlistKillmails(first: Int, after: String, last: Int, before: String): KillmailConnection!
+}
+
+extend type Mutation {
+    watch(entity: WatchEntity!, id: Int!): WatchlistEntry!
+    # Returns false when the entity was not on the watchlist
+    unwatch(entity: WatchEntity!, id: Int!): Boolean!
+}
+
+enum WatchEntity @goModel(model: "github.com/eveisesi/neo.WatchEntity") {
+    character
+    corporation
+    alliance
+    system
+    ship
+}
+
+type WatchlistEntry @goModel(model: "github.com/eveisesi/neo.WatchlistEntry") {
+    entity: WatchEntity!
+    entityID: Int!
+    createdAt: Time!
+
+    # Only the field matching the entity of the entry is populated
+    character: Character @goField(forceResolver: true)
+    corporation: Corporation @goField(forceResolver: true)
+    alliance: Alliance @goField(forceResolver: true)
+    system: SolarSystem @goField(forceResolver: true)
+    ship: Type @goField(forceResolver: true)
+}
 `, BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
@@ -1679,117 +2908,297 @@ var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
 // region    ***************************** args.gotpl *****************************
 
-func (ec *executionContext) field_Killmail_attackers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Alliance_stats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *bool
-	if tmp, ok := rawArgs["finalBlowOnly"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("finalBlowOnly"))
-		arg0, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	var arg0 *neo.StatPeriod
+	if tmp, ok := rawArgs["period"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("period"))
+		arg0, err = ec.unmarshalOStatPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["finalBlowOnly"] = arg0
+	args["period"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Character_stats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 string
-	if tmp, ok := rawArgs["name"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+	var arg0 *neo.StatPeriod
+	if tmp, ok := rawArgs["period"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("period"))
+		arg0, err = ec.unmarshalOStatPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["name"] = arg0
+	args["period"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_allianceByAllianceID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Corporation_stats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 int
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+	var arg0 *neo.StatPeriod
+	if tmp, ok := rawArgs["period"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("period"))
+		arg0, err = ec.unmarshalOStatPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["period"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_categoryByGroupID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Killmail_attackers_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 int
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+	var arg0 *bool
+	if tmp, ok := rawArgs["finalBlowOnly"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("finalBlowOnly"))
+		arg0, err = ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["finalBlowOnly"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_characterByCharacterID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Killmail_related_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 int
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+	var arg0 *int
+	if tmp, ok := rawArgs["windowMinutes"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("windowMinutes"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["windowMinutes"] = arg0
+	var arg1 *neo.RelatedRadius
+	if tmp, ok := rawArgs["radius"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("radius"))
+		arg1, err = ec.unmarshalORelatedRadius2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRelatedRadius(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["radius"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_constellationByConstellationID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_unwatch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 int
+	var arg0 neo.WatchEntity
+	if tmp, ok := rawArgs["entity"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entity"))
+		arg0, err = ec.unmarshalNWatchEntity2githubᚗcomᚋeveisesiᚋneoᚐWatchEntity(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["entity"] = arg0
+	var arg1 int
 	if tmp, ok := rawArgs["id"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		arg1, err = ec.unmarshalNInt2int(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["id"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_corporationByCorporationID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_watch_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 int
+	var arg0 neo.WatchEntity
+	if tmp, ok := rawArgs["entity"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entity"))
+		arg0, err = ec.unmarshalNWatchEntity2githubᚗcomᚋeveisesiᚋneoᚐWatchEntity(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["entity"] = arg0
+	var arg1 int
 	if tmp, ok := rawArgs["id"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		arg1, err = ec.unmarshalNInt2int(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["id"] = arg1
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_groupByGroupID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 int
-	if tmp, ok := rawArgs["id"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_activity_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.Entity
+	if tmp, ok := rawArgs["entity"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entity"))
+		arg0, err = ec.unmarshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["entity"] = arg0
+	var arg1 int
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg1, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["days"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("days"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["days"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_allianceByAllianceID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_battleReport_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_battleReports_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.BattleReportFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalNBattleReportFilter2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBattleReportFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_categoryByGroupID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_characterByCharacterID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_constellationByConstellationID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_corporationByCorporationID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_groupByGroupID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 int
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
 		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
 		if err != nil {
 			return nil, err
@@ -1851,23 +3260,110 @@ func (ec *executionContext) field_Query_killmailsByEntityID_args(ctx context.Con
 	}
 	args["id"] = arg1
 	var arg2 *int
-	if tmp, ok := rawArgs["page"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
 		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["page"] = arg2
-	var arg3 *models.KillmailFilter
+	args["first"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg4
+	var arg5 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg5, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg5
+	var arg6 *models.KillmailFilter
 	if tmp, ok := rawArgs["filter"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
-		arg3, err = ec.unmarshalOKillmailFilter2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐKillmailFilter(ctx, tmp)
+		arg6, err = ec.unmarshalOKillmailFilter2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐKillmailFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg6
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_leaderboard_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 models.Entity
+	if tmp, ok := rawArgs["category"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("category"))
+		arg0, err = ec.unmarshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["category"] = arg0
+	var arg1 *models.Entity
+	if tmp, ok := rawArgs["scope"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scope"))
+		arg1, err = ec.unmarshalOEntity2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["scope"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["scopeID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scopeID"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["scopeID"] = arg2
+	var arg3 *neo.LeaderboardPeriod
+	if tmp, ok := rawArgs["period"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("period"))
+		arg3, err = ec.unmarshalOLeaderboardPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardPeriod(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["period"] = arg3
+	var arg4 *neo.LeaderboardMetric
+	if tmp, ok := rawArgs["metric"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("metric"))
+		arg4, err = ec.unmarshalOLeaderboardMetric2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardMetric(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["metric"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["filter"] = arg3
+	args["limit"] = arg5
 	return args, nil
 }
 
@@ -1952,37 +3448,163 @@ func (ec *executionContext) field_Query_solarSystemBySolarSystemID_args(ctx cont
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_typeByTypeID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_topShips_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 int
+	var arg0 models.Entity
+	if tmp, ok := rawArgs["entity"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("entity"))
+		arg0, err = ec.unmarshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["entity"] = arg0
+	var arg1 int
 	if tmp, ok := rawArgs["id"]; ok {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
+		arg1, err = ec.unmarshalNInt2int(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["id"] = arg0
+	args["id"] = arg1
+	var arg2 neo.ShipUsage
+	if tmp, ok := rawArgs["usage"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("usage"))
+		arg2, err = ec.unmarshalNShipUsage2githubᚗcomᚋeveisesiᚋneoᚐShipUsage(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["usage"] = arg2
+	var arg3 *neo.ShipGrouping
+	if tmp, ok := rawArgs["grouping"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("grouping"))
+		arg3, err = ec.unmarshalOShipGrouping2ᚖgithubᚗcomᚋeveisesiᚋneoᚐShipGrouping(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["grouping"] = arg3
+	var arg4 *neo.StatPeriod
+	if tmp, ok := rawArgs["period"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("period"))
+		arg4, err = ec.unmarshalOStatPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["period"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg5
+	var arg6 *models.KillmailFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg6, err = ec.unmarshalOKillmailFilter2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐKillmailFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg6
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_typeByTypeID_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	var arg0 int
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNInt2int(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_watchlistKillmails_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("first"))
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg1
+	var arg2 *int
+	if tmp, ok := rawArgs["last"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("last"))
+		arg2, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["last"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["before"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("before"))
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["before"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_killmailFeed_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *models.KillmailFeedFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("filter"))
+		arg0, err = ec.unmarshalOKillmailFeedFilter2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐKillmailFeedFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 bool
@@ -2005,7 +3627,7 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _Alliance_id(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Activity_days(ctx context.Context, field graphql.CollectedField, obj *neo.Activity) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2013,7 +3635,7 @@ func (ec *executionContext) _Alliance_id(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Alliance",
+		Object:     "Activity",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2023,7 +3645,7 @@ func (ec *executionContext) _Alliance_id(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Days, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2035,12 +3657,12 @@ func (ec *executionContext) _Alliance_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Alliance_name(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Activity_kills(ctx context.Context, field graphql.CollectedField, obj *neo.Activity) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2048,7 +3670,7 @@ func (ec *executionContext) _Alliance_name(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Alliance",
+		Object:     "Activity",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2058,7 +3680,7 @@ func (ec *executionContext) _Alliance_name(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Kills, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2070,12 +3692,12 @@ func (ec *executionContext) _Alliance_name(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([][]uint)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚕᚕuintᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Alliance_ticker(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Activity_losses(ctx context.Context, field graphql.CollectedField, obj *neo.Activity) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2083,7 +3705,7 @@ func (ec *executionContext) _Alliance_ticker(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Alliance",
+		Object:     "Activity",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2093,7 +3715,7 @@ func (ec *executionContext) _Alliance_ticker(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ticker, nil
+		return obj.Losses, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2105,12 +3727,12 @@ func (ec *executionContext) _Alliance_ticker(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([][]uint)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚕᚕuintᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Alliance_memberCount(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Alliance_id(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2121,14 +3743,14 @@ func (ec *executionContext) _Alliance_memberCount(ctx context.Context, field gra
 		Object:     "Alliance",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Alliance().MemberCount(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2140,12 +3762,12 @@ func (ec *executionContext) _Alliance_memberCount(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Character_id(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
+func (ec *executionContext) _Alliance_name(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2153,7 +3775,7 @@ func (ec *executionContext) _Character_id(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Character",
+		Object:     "Alliance",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2163,7 +3785,7 @@ func (ec *executionContext) _Character_id(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2175,12 +3797,12 @@ func (ec *executionContext) _Character_id(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2uint64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Character_name(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
+func (ec *executionContext) _Alliance_ticker(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2188,7 +3810,7 @@ func (ec *executionContext) _Character_name(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Character",
+		Object:     "Alliance",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2198,7 +3820,7 @@ func (ec *executionContext) _Character_name(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Ticker, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2215,7 +3837,7 @@ func (ec *executionContext) _Character_name(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Character_securityStatus(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
+func (ec *executionContext) _Alliance_memberCount(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2223,17 +3845,17 @@ func (ec *executionContext) _Character_securityStatus(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Character",
+		Object:     "Alliance",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SecurityStatus, nil
+		return ec.resolvers.Alliance().MemberCount(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2245,12 +3867,12 @@ func (ec *executionContext) _Character_securityStatus(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Character_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
+func (ec *executionContext) _Alliance_stats(ctx context.Context, field graphql.CollectedField, obj *neo.Alliance) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2258,7 +3880,7 @@ func (ec *executionContext) _Character_corporation(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Character",
+		Object:     "Alliance",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -2266,9 +3888,16 @@ func (ec *executionContext) _Character_corporation(ctx context.Context, field gr
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Alliance_stats_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Character().Corporation(rctx, obj)
+		return ec.resolvers.Alliance().Stats(rctx, obj, args["period"].(*neo.StatPeriod))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2280,12 +3909,12 @@ func (ec *executionContext) _Character_corporation(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Corporation)
+	res := resTmp.(*neo.EntityStats)
 	fc.Result = res
-	return ec.marshalNCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
+	return ec.marshalNEntityStats2ᚖgithubᚗcomᚋeveisesiᚋneoᚐEntityStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Constellation_id(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_id(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2293,7 +3922,7 @@ func (ec *executionContext) _Constellation_id(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constellation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2315,12 +3944,12 @@ func (ec *executionContext) _Constellation_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Constellation_name(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_scope(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2328,7 +3957,7 @@ func (ec *executionContext) _Constellation_name(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constellation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2338,7 +3967,7 @@ func (ec *executionContext) _Constellation_name(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Scope, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2350,12 +3979,12 @@ func (ec *executionContext) _Constellation_name(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(neo.BattleScope)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBattleScope2githubᚗcomᚋeveisesiᚋneoᚐBattleScope(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Constellation_regionID(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_locationID(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2363,7 +3992,7 @@ func (ec *executionContext) _Constellation_regionID(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constellation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2373,7 +4002,7 @@ func (ec *executionContext) _Constellation_regionID(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RegionID, nil
+		return obj.LocationID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2390,7 +4019,7 @@ func (ec *executionContext) _Constellation_regionID(ctx context.Context, field g
 	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Constellation_factionID(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_startTime(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2398,7 +4027,7 @@ func (ec *executionContext) _Constellation_factionID(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constellation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2408,21 +4037,24 @@ func (ec *executionContext) _Constellation_factionID(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FactionID, nil
+		return obj.StartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Constellation_region(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_endTime(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2430,17 +4062,17 @@ func (ec *executionContext) _Constellation_region(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Constellation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Constellation().Region(rctx, obj)
+		return obj.EndTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2452,12 +4084,12 @@ func (ec *executionContext) _Constellation_region(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Region)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNRegion2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRegion(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Corporation_id(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_totalValue(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2465,7 +4097,7 @@ func (ec *executionContext) _Corporation_id(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Corporation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2475,7 +4107,7 @@ func (ec *executionContext) _Corporation_id(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.TotalValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2487,12 +4119,12 @@ func (ec *executionContext) _Corporation_id(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Corporation_name(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_killmails(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2500,7 +4132,7 @@ func (ec *executionContext) _Corporation_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Corporation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2510,7 +4142,7 @@ func (ec *executionContext) _Corporation_name(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Killmails, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2522,12 +4154,12 @@ func (ec *executionContext) _Corporation_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*neo.Killmail)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Corporation_ticker(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_sides(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2535,7 +4167,7 @@ func (ec *executionContext) _Corporation_ticker(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Corporation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2545,7 +4177,7 @@ func (ec *executionContext) _Corporation_ticker(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ticker, nil
+		return obj.Sides, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2557,12 +4189,12 @@ func (ec *executionContext) _Corporation_ticker(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*neo.BattleSide)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBattleSide2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleSide(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Corporation_memberCount(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_system(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2570,34 +4202,31 @@ func (ec *executionContext) _Corporation_memberCount(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Corporation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MemberCount, nil
+		return ec.resolvers.BattleReport().System(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(*neo.SolarSystem)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalOSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Corporation_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleReport_constellation(ctx context.Context, field graphql.CollectedField, obj *neo.BattleReport) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2605,7 +4234,7 @@ func (ec *executionContext) _Corporation_alliance(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Corporation",
+		Object:     "BattleReport",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -2615,7 +4244,7 @@ func (ec *executionContext) _Corporation_alliance(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Corporation().Alliance(rctx, obj)
+		return ec.resolvers.BattleReport().Constellation(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2624,12 +4253,12 @@ func (ec *executionContext) _Corporation_alliance(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Alliance)
+	res := resTmp.(*neo.Constellation)
 	fc.Result = res
-	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
+	return ec.marshalOConstellation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_id(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_allianceIDs(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2637,7 +4266,7 @@ func (ec *executionContext) _Killmail_id(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2647,7 +4276,7 @@ func (ec *executionContext) _Killmail_id(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.AllianceIDs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2659,12 +4288,12 @@ func (ec *executionContext) _Killmail_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.([]uint)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚕuintᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_hash(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_corporationIDs(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2672,7 +4301,7 @@ func (ec *executionContext) _Killmail_hash(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2682,7 +4311,7 @@ func (ec *executionContext) _Killmail_hash(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hash, nil
+		return obj.CorporationIDs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2694,12 +4323,12 @@ func (ec *executionContext) _Killmail_hash(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]uint)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2ᚕuintᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_moonID(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_pilots(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2707,31 +4336,34 @@ func (ec *executionContext) _Killmail_moonID(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MoonID, nil
+		return ec.resolvers.BattleSide().Pilots(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_solarSystemID(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_iskKilled(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2739,7 +4371,7 @@ func (ec *executionContext) _Killmail_solarSystemID(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2749,7 +4381,7 @@ func (ec *executionContext) _Killmail_solarSystemID(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SolarSystemID, nil
+		return obj.ISKKilled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2761,12 +4393,12 @@ func (ec *executionContext) _Killmail_solarSystemID(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_warID(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_iskLost(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2774,7 +4406,7 @@ func (ec *executionContext) _Killmail_warID(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2784,21 +4416,24 @@ func (ec *executionContext) _Killmail_warID(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.WarID, nil
+		return obj.ISKLost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_isNPC(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_shipsKilled(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2806,7 +4441,7 @@ func (ec *executionContext) _Killmail_isNPC(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2816,7 +4451,7 @@ func (ec *executionContext) _Killmail_isNPC(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsNPC, nil
+		return obj.ShipsKilled, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2828,12 +4463,12 @@ func (ec *executionContext) _Killmail_isNPC(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_isAwox(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_shipsLost(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2841,7 +4476,7 @@ func (ec *executionContext) _Killmail_isAwox(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2851,7 +4486,7 @@ func (ec *executionContext) _Killmail_isAwox(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsAwox, nil
+		return obj.ShipsLost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2863,12 +4498,12 @@ func (ec *executionContext) _Killmail_isAwox(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_isSolo(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_efficiency(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2876,7 +4511,7 @@ func (ec *executionContext) _Killmail_isSolo(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2886,7 +4521,7 @@ func (ec *executionContext) _Killmail_isSolo(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsSolo, nil
+		return obj.Efficiency, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2898,12 +4533,12 @@ func (ec *executionContext) _Killmail_isSolo(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_droppedValue(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_alliances(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2911,17 +4546,17 @@ func (ec *executionContext) _Killmail_droppedValue(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DroppedValue, nil
+		return ec.resolvers.BattleSide().Alliances(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2933,12 +4568,12 @@ func (ec *executionContext) _Killmail_droppedValue(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.([]*neo.Alliance)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNAlliance2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_destroyedValue(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _BattleSide_corporations(ctx context.Context, field graphql.CollectedField, obj *neo.BattleSide) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2946,17 +4581,17 @@ func (ec *executionContext) _Killmail_destroyedValue(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "BattleSide",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DestroyedValue, nil
+		return ec.resolvers.BattleSide().Corporations(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2968,12 +4603,12 @@ func (ec *executionContext) _Killmail_destroyedValue(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.([]*neo.Corporation)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNCorporation2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_fittedValue(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _Character_id(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2981,7 +4616,7 @@ func (ec *executionContext) _Killmail_fittedValue(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "Character",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -2991,7 +4626,7 @@ func (ec *executionContext) _Killmail_fittedValue(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FittedValue, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3003,12 +4638,12 @@ func (ec *executionContext) _Killmail_fittedValue(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_totalValue(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _Character_name(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3016,7 +4651,7 @@ func (ec *executionContext) _Killmail_totalValue(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "Character",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3026,7 +4661,7 @@ func (ec *executionContext) _Killmail_totalValue(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalValue, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3038,12 +4673,12 @@ func (ec *executionContext) _Killmail_totalValue(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_killmailTime(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _Character_securityStatus(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3051,7 +4686,7 @@ func (ec *executionContext) _Killmail_killmailTime(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "Character",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3061,7 +4696,7 @@ func (ec *executionContext) _Killmail_killmailTime(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.KillmailTime, nil
+		return obj.SecurityStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3073,12 +4708,12 @@ func (ec *executionContext) _Killmail_killmailTime(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_system(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _Character_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3086,7 +4721,7 @@ func (ec *executionContext) _Killmail_system(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "Character",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3096,7 +4731,7 @@ func (ec *executionContext) _Killmail_system(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Killmail().System(rctx, obj)
+		return ec.resolvers.Character().Corporation(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3108,12 +4743,12 @@ func (ec *executionContext) _Killmail_system(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.SolarSystem)
+	res := resTmp.(*neo.Corporation)
 	fc.Result = res
-	return ec.marshalNSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx, field.Selections, res)
+	return ec.marshalNCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_attackers(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _Character_stats(ctx context.Context, field graphql.CollectedField, obj *neo.Character) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3121,7 +4756,7 @@ func (ec *executionContext) _Killmail_attackers(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "Character",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -3130,7 +4765,7 @@ func (ec *executionContext) _Killmail_attackers(ctx context.Context, field graph
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Killmail_attackers_args(ctx, rawArgs)
+	args, err := ec.field_Character_stats_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -3138,7 +4773,7 @@ func (ec *executionContext) _Killmail_attackers(ctx context.Context, field graph
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Killmail().Attackers(rctx, obj, args["finalBlowOnly"].(*bool))
+		return ec.resolvers.Character().Stats(rctx, obj, args["period"].(*neo.StatPeriod))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3150,12 +4785,12 @@ func (ec *executionContext) _Killmail_attackers(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*neo.KillmailAttacker)
+	res := resTmp.(*neo.EntityStats)
 	fc.Result = res
-	return ec.marshalNKillmailAttacker2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailAttacker(ctx, field.Selections, res)
+	return ec.marshalNEntityStats2ᚖgithubᚗcomᚋeveisesiᚋneoᚐEntityStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Killmail_victim(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constellation_id(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3163,7 +4798,7 @@ func (ec *executionContext) _Killmail_victim(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Killmail",
+		Object:     "Constellation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3173,7 +4808,7 @@ func (ec *executionContext) _Killmail_victim(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Victim, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3185,12 +4820,12 @@ func (ec *executionContext) _Killmail_victim(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.KillmailVictim)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNKillmailVictim2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailVictim(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_killmailID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constellation_name(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3198,7 +4833,7 @@ func (ec *executionContext) _KillmailAttacker_killmailID(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Constellation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3208,7 +4843,7 @@ func (ec *executionContext) _KillmailAttacker_killmailID(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.KillmailID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3220,12 +4855,12 @@ func (ec *executionContext) _KillmailAttacker_killmailID(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_allianceID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constellation_regionID(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3233,7 +4868,7 @@ func (ec *executionContext) _KillmailAttacker_allianceID(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Constellation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3243,21 +4878,24 @@ func (ec *executionContext) _KillmailAttacker_allianceID(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AllianceID, nil
+		return obj.RegionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_characterID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constellation_factionID(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3265,7 +4903,7 @@ func (ec *executionContext) _KillmailAttacker_characterID(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Constellation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3275,7 +4913,7 @@ func (ec *executionContext) _KillmailAttacker_characterID(ctx context.Context, f
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CharacterID, nil
+		return obj.FactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3284,12 +4922,12 @@ func (ec *executionContext) _KillmailAttacker_characterID(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*uint64)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint64(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_corporationID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Constellation_region(ctx context.Context, field graphql.CollectedField, obj *neo.Constellation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3297,31 +4935,34 @@ func (ec *executionContext) _KillmailAttacker_corporationID(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Constellation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CorporationID, nil
+		return ec.resolvers.Constellation().Region(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(*neo.Region)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNRegion2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRegion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_factionID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Corporation_id(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3329,7 +4970,7 @@ func (ec *executionContext) _KillmailAttacker_factionID(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Corporation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3339,21 +4980,24 @@ func (ec *executionContext) _KillmailAttacker_factionID(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FactionID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_damageDone(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Corporation_name(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3361,7 +5005,7 @@ func (ec *executionContext) _KillmailAttacker_damageDone(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Corporation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3371,7 +5015,7 @@ func (ec *executionContext) _KillmailAttacker_damageDone(ctx context.Context, fi
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DamageDone, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3383,12 +5027,12 @@ func (ec *executionContext) _KillmailAttacker_damageDone(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_finalBlow(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Corporation_ticker(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3396,7 +5040,7 @@ func (ec *executionContext) _KillmailAttacker_finalBlow(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Corporation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3406,7 +5050,7 @@ func (ec *executionContext) _KillmailAttacker_finalBlow(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FinalBlow, nil
+		return obj.Ticker, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3418,12 +5062,12 @@ func (ec *executionContext) _KillmailAttacker_finalBlow(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_securityStatus(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Corporation_memberCount(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3431,7 +5075,7 @@ func (ec *executionContext) _KillmailAttacker_securityStatus(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Corporation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3441,7 +5085,7 @@ func (ec *executionContext) _KillmailAttacker_securityStatus(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SecurityStatus, nil
+		return obj.MemberCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3453,12 +5097,12 @@ func (ec *executionContext) _KillmailAttacker_securityStatus(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_shipTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Corporation_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3466,17 +5110,17 @@ func (ec *executionContext) _KillmailAttacker_shipTypeID(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Corporation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ShipTypeID, nil
+		return ec.resolvers.Corporation().Alliance(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3485,12 +5129,12 @@ func (ec *executionContext) _KillmailAttacker_shipTypeID(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(*neo.Alliance)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_weaponTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _Corporation_stats(ctx context.Context, field graphql.CollectedField, obj *neo.Corporation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3498,31 +5142,41 @@ func (ec *executionContext) _KillmailAttacker_weaponTypeID(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "Corporation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Corporation_stats_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.WeaponTypeID, nil
+		return ec.resolvers.Corporation().Stats(rctx, obj, args["period"].(*neo.StatPeriod))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(*neo.EntityStats)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNEntityStats2ᚖgithubᚗcomᚋeveisesiᚋneoᚐEntityStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_period(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3530,31 +5184,34 @@ func (ec *executionContext) _KillmailAttacker_alliance(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailAttacker().Alliance(rctx, obj)
+		return obj.Period, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Alliance)
+	res := resTmp.(neo.StatPeriod)
 	fc.Result = res
-	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
+	return ec.marshalNStatPeriod2githubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_kills(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3562,31 +5219,34 @@ func (ec *executionContext) _KillmailAttacker_corporation(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailAttacker().Corporation(rctx, obj)
+		return obj.Kills, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Corporation)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalOCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_character(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_losses(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3594,31 +5254,34 @@ func (ec *executionContext) _KillmailAttacker_character(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailAttacker().Character(rctx, obj)
+		return obj.Losses, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Character)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalOCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_ship(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_iskDestroyed(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3626,31 +5289,34 @@ func (ec *executionContext) _KillmailAttacker_ship(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailAttacker().Ship(rctx, obj)
+		return obj.ISKDestroyed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Type)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailAttacker_weapon(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_iskLost(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3658,31 +5324,34 @@ func (ec *executionContext) _KillmailAttacker_weapon(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailAttacker",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailAttacker().Weapon(rctx, obj)
+		return obj.ISKLost, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Type)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_killmailID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_iskEfficiency(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3690,7 +5359,7 @@ func (ec *executionContext) _KillmailItem_killmailID(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3700,7 +5369,7 @@ func (ec *executionContext) _KillmailItem_killmailID(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.KillmailID, nil
+		return obj.ISKEfficiency, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3712,12 +5381,12 @@ func (ec *executionContext) _KillmailItem_killmailID(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_flag(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_soloKills(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3725,7 +5394,7 @@ func (ec *executionContext) _KillmailItem_flag(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3735,7 +5404,7 @@ func (ec *executionContext) _KillmailItem_flag(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Flag, nil
+		return obj.SoloKills, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3752,7 +5421,7 @@ func (ec *executionContext) _KillmailItem_flag(ctx context.Context, field graphq
 	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_itemTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_dangerRatio(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3760,7 +5429,7 @@ func (ec *executionContext) _KillmailItem_itemTypeID(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3770,7 +5439,7 @@ func (ec *executionContext) _KillmailItem_itemTypeID(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ItemTypeID, nil
+		return obj.DangerRatio, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3782,12 +5451,12 @@ func (ec *executionContext) _KillmailItem_itemTypeID(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_quantityDropped(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _EntityStats_averageGangSize(ctx context.Context, field graphql.CollectedField, obj *neo.EntityStats) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3795,7 +5464,7 @@ func (ec *executionContext) _KillmailItem_quantityDropped(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "EntityStats",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3805,21 +5474,24 @@ func (ec *executionContext) _KillmailItem_quantityDropped(ctx context.Context, f
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QuantityDropped, nil
+		return obj.AverageGangSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_quantityDestroyed(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_id(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3827,7 +5499,7 @@ func (ec *executionContext) _KillmailItem_quantityDestroyed(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3837,21 +5509,24 @@ func (ec *executionContext) _KillmailItem_quantityDestroyed(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QuantityDestroyed, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_singleton(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_hash(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3859,7 +5534,7 @@ func (ec *executionContext) _KillmailItem_singleton(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3869,7 +5544,7 @@ func (ec *executionContext) _KillmailItem_singleton(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Singleton, nil
+		return obj.Hash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3881,12 +5556,12 @@ func (ec *executionContext) _KillmailItem_singleton(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint8)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2uint8(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_isParent(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_moonID(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3894,7 +5569,7 @@ func (ec *executionContext) _KillmailItem_isParent(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3904,24 +5579,21 @@ func (ec *executionContext) _KillmailItem_isParent(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsParent, nil
+		return obj.MoonID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_itemValue(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_solarSystemID(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3929,7 +5601,7 @@ func (ec *executionContext) _KillmailItem_itemValue(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3939,7 +5611,7 @@ func (ec *executionContext) _KillmailItem_itemValue(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ItemValue, nil
+		return obj.SolarSystemID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3951,12 +5623,12 @@ func (ec *executionContext) _KillmailItem_itemValue(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_totalValue(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_warID(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3964,7 +5636,7 @@ func (ec *executionContext) _KillmailItem_totalValue(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -3974,24 +5646,21 @@ func (ec *executionContext) _KillmailItem_totalValue(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalValue, nil
+		return obj.WarID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_type(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_isNPC(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3999,31 +5668,34 @@ func (ec *executionContext) _KillmailItem_type(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailItem().Type(rctx, obj)
+		return obj.IsNPC, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_typeflag(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_isAwox(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4031,31 +5703,34 @@ func (ec *executionContext) _KillmailItem_typeflag(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailItem().Typeflag(rctx, obj)
+		return obj.IsAwox, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.TypeFlag)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOTypeFlag2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeFlag(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailItem_items(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_isSolo(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4063,7 +5738,7 @@ func (ec *executionContext) _KillmailItem_items(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailItem",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4073,7 +5748,7 @@ func (ec *executionContext) _KillmailItem_items(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Items, nil
+		return obj.IsSolo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4085,12 +5760,12 @@ func (ec *executionContext) _KillmailItem_items(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*neo.KillmailItem)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_killmailID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_droppedValue(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4098,7 +5773,7 @@ func (ec *executionContext) _KillmailVictim_killmailID(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4108,7 +5783,7 @@ func (ec *executionContext) _KillmailVictim_killmailID(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.KillmailID, nil
+		return obj.DroppedValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4120,12 +5795,12 @@ func (ec *executionContext) _KillmailVictim_killmailID(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_allianceID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_destroyedValue(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4133,7 +5808,7 @@ func (ec *executionContext) _KillmailVictim_allianceID(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4143,21 +5818,24 @@ func (ec *executionContext) _KillmailVictim_allianceID(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AllianceID, nil
+		return obj.DestroyedValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_characterID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_fittedValue(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4165,7 +5843,7 @@ func (ec *executionContext) _KillmailVictim_characterID(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4175,21 +5853,24 @@ func (ec *executionContext) _KillmailVictim_characterID(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CharacterID, nil
+		return obj.FittedValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint64)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint64(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_corporationID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_totalValue(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4197,7 +5878,7 @@ func (ec *executionContext) _KillmailVictim_corporationID(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4207,7 +5888,7 @@ func (ec *executionContext) _KillmailVictim_corporationID(ctx context.Context, f
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CorporationID, nil
+		return obj.TotalValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4219,12 +5900,12 @@ func (ec *executionContext) _KillmailVictim_corporationID(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_factionID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_killmailTime(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4232,7 +5913,7 @@ func (ec *executionContext) _KillmailVictim_factionID(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4242,21 +5923,24 @@ func (ec *executionContext) _KillmailVictim_factionID(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FactionID, nil
+		return obj.KillmailTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_damageTaken(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_tags(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4264,17 +5948,17 @@ func (ec *executionContext) _KillmailVictim_damageTaken(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DamageTaken, nil
+		return ec.resolvers.Killmail().Tags(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4286,12 +5970,12 @@ func (ec *executionContext) _KillmailVictim_damageTaken(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.([]neo.KillmailTag)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNKillmailTag2ᚕgithubᚗcomᚋeveisesiᚋneoᚐKillmailTagᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_shipTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_system(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4299,17 +5983,17 @@ func (ec *executionContext) _KillmailVictim_shipTypeID(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ShipTypeID, nil
+		return ec.resolvers.Killmail().System(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4321,12 +6005,12 @@ func (ec *executionContext) _KillmailVictim_shipTypeID(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(*neo.SolarSystem)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_shipValue(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_attackers(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4334,17 +6018,24 @@ func (ec *executionContext) _KillmailVictim_shipValue(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Killmail_attackers_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ShipValue, nil
+		return ec.resolvers.Killmail().Attackers(rctx, obj, args["finalBlowOnly"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4356,12 +6047,12 @@ func (ec *executionContext) _KillmailVictim_shipValue(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.([]*neo.KillmailAttacker)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNKillmailAttacker2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailAttacker(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_victim(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4369,31 +6060,34 @@ func (ec *executionContext) _KillmailVictim_alliance(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailVictim().Alliance(rctx, obj)
+		return obj.Victim, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Alliance)
+	res := resTmp.(*neo.KillmailVictim)
 	fc.Result = res
-	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
+	return ec.marshalNKillmailVictim2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailVictim(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _Killmail_related(ctx context.Context, field graphql.CollectedField, obj *neo.Killmail) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4401,7 +6095,7 @@ func (ec *executionContext) _KillmailVictim_corporation(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "Killmail",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4409,23 +6103,33 @@ func (ec *executionContext) _KillmailVictim_corporation(ctx context.Context, fie
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Killmail_related_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailVictim().Corporation(rctx, obj)
+		return ec.resolvers.Killmail().Related(rctx, obj, args["windowMinutes"].(*int), args["radius"].(*neo.RelatedRadius))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Corporation)
+	res := resTmp.([]*neo.RelatedKillmails)
 	fc.Result = res
-	return ec.marshalOCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
+	return ec.marshalNRelatedKillmails2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐRelatedKillmails(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_character(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_killmailID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4433,31 +6137,34 @@ func (ec *executionContext) _KillmailVictim_character(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailVictim().Character(rctx, obj)
+		return obj.KillmailID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Character)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalOCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_ship(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_allianceID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4465,17 +6172,17 @@ func (ec *executionContext) _KillmailVictim_ship(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailVictim().Ship(rctx, obj)
+		return obj.AllianceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4484,12 +6191,12 @@ func (ec *executionContext) _KillmailVictim_ship(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Type)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_position(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_characterID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4497,7 +6204,7 @@ func (ec *executionContext) _KillmailVictim_position(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4507,7 +6214,7 @@ func (ec *executionContext) _KillmailVictim_position(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Position, nil
+		return obj.CharacterID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4516,12 +6223,12 @@ func (ec *executionContext) _KillmailVictim_position(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Position)
+	res := resTmp.(*uint64)
 	fc.Result = res
-	return ec.marshalOPosition2ᚖgithubᚗcomᚋeveisesiᚋneoᚐPosition(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_items(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_corporationID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4529,34 +6236,31 @@ func (ec *executionContext) _KillmailVictim_items(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailVictim().Items(rctx, obj)
+		return obj.CorporationID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*neo.KillmailItem)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KillmailVictim_fitted(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_factionID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4564,34 +6268,31 @@ func (ec *executionContext) _KillmailVictim_fitted(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "KillmailVictim",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.KillmailVictim().Fitted(rctx, obj)
+		return obj.FactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*neo.KillmailItem)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_mutationPlaceholder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_damageDone(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4599,17 +6300,17 @@ func (ec *executionContext) _Mutation_mutationPlaceholder(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().MutationPlaceholder(rctx)
+		return obj.DamageDone, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4621,12 +6322,12 @@ func (ec *executionContext) _Mutation_mutationPlaceholder(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Position_x(ctx context.Context, field graphql.CollectedField, obj *neo.Position) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_finalBlow(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4634,7 +6335,7 @@ func (ec *executionContext) _Position_x(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Position",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4644,21 +6345,24 @@ func (ec *executionContext) _Position_x(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.X, nil
+		return obj.FinalBlow, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Position_y(ctx context.Context, field graphql.CollectedField, obj *neo.Position) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_securityStatus(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4666,7 +6370,7 @@ func (ec *executionContext) _Position_y(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Position",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4676,21 +6380,24 @@ func (ec *executionContext) _Position_y(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Y, nil
+		return obj.SecurityStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
 	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Position_z(ctx context.Context, field graphql.CollectedField, obj *neo.Position) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_shipTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4698,7 +6405,7 @@ func (ec *executionContext) _Position_z(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Position",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -4708,7 +6415,7 @@ func (ec *executionContext) _Position_z(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Z, nil
+		return obj.ShipTypeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4717,12 +6424,12 @@ func (ec *executionContext) _Position_z(ctx context.Context, field graphql.Colle
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalOFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_queryPlaceholder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_weaponTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4730,34 +6437,31 @@ func (ec *executionContext) _Query_queryPlaceholder(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().QueryPlaceholder(rctx)
+		return obj.WeaponTypeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_allianceByAllianceID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4765,7 +6469,7 @@ func (ec *executionContext) _Query_allianceByAllianceID(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4773,33 +6477,23 @@ func (ec *executionContext) _Query_allianceByAllianceID(ctx context.Context, fie
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_allianceByAllianceID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().AllianceByAllianceID(rctx, args["id"].(int))
+		return ec.resolvers.KillmailAttacker().Alliance(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(*neo.Alliance)
 	fc.Result = res
-	return ec.marshalNAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
+	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_characterByCharacterID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4807,7 +6501,7 @@ func (ec *executionContext) _Query_characterByCharacterID(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4815,33 +6509,23 @@ func (ec *executionContext) _Query_characterByCharacterID(ctx context.Context, f
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_characterByCharacterID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CharacterByCharacterID(rctx, args["id"].(int))
+		return ec.resolvers.KillmailAttacker().Corporation(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Character)
+	res := resTmp.(*neo.Corporation)
 	fc.Result = res
-	return ec.marshalNCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
+	return ec.marshalOCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_corporationByCorporationID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_character(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4849,7 +6533,7 @@ func (ec *executionContext) _Query_corporationByCorporationID(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4857,33 +6541,23 @@ func (ec *executionContext) _Query_corporationByCorporationID(ctx context.Contex
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_corporationByCorporationID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CorporationByCorporationID(rctx, args["id"].(int))
+		return ec.resolvers.KillmailAttacker().Character(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Corporation)
+	res := resTmp.(*neo.Character)
 	fc.Result = res
-	return ec.marshalNCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
+	return ec.marshalOCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_killmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_ship(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4891,7 +6565,7 @@ func (ec *executionContext) _Query_killmail(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4899,33 +6573,23 @@ func (ec *executionContext) _Query_killmail(ctx context.Context, field graphql.C
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_killmail_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Killmail(rctx, args["id"].(int))
+		return ec.resolvers.KillmailAttacker().Ship(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Killmail)
+	res := resTmp.(*neo.Type)
 	fc.Result = res
-	return ec.marshalNKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
+	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_killmailRecent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailAttacker_weapon(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailAttacker) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4933,7 +6597,7 @@ func (ec *executionContext) _Query_killmailRecent(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailAttacker",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -4941,33 +6605,23 @@ func (ec *executionContext) _Query_killmailRecent(ctx context.Context, field gra
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_killmailRecent_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().KillmailRecent(rctx, args["page"].(*int))
+		return ec.resolvers.KillmailAttacker().Weapon(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*neo.Killmail)
+	res := resTmp.(*neo.Type)
 	fc.Result = res
-	return ec.marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
+	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_mvByEntityID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailConnection_edges(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4975,24 +6629,17 @@ func (ec *executionContext) _Query_mvByEntityID(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailConnection",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_mvByEntityID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().MvByEntityID(rctx, args["category"].(*models.Category), args["entity"].(*models.Entity), args["id"].(*int), args["age"].(*int), args["limit"].(*int))
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5004,12 +6651,12 @@ func (ec *executionContext) _Query_mvByEntityID(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*neo.Killmail)
+	res := resTmp.([]*neo.KillmailEdge)
 	fc.Result = res
-	return ec.marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
+	return ec.marshalNKillmailEdge2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_killmailsByEntityID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5017,24 +6664,17 @@ func (ec *executionContext) _Query_killmailsByEntityID(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailConnection",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_killmailsByEntityID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().KillmailsByEntityID(rctx, args["entity"].(models.Entity), args["id"].(int), args["page"].(*int), args["filter"].(*models.KillmailFilter))
+		return obj.PageInfo, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5046,12 +6686,12 @@ func (ec *executionContext) _Query_killmailsByEntityID(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*neo.Killmail)
+	res := resTmp.(*neo.PageInfo)
 	fc.Result = res
-	return ec.marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋeveisesiᚋneoᚐPageInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_typeByTypeID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailConnection) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5059,24 +6699,17 @@ func (ec *executionContext) _Query_typeByTypeID(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailConnection",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_typeByTypeID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().TypeByTypeID(rctx, args["id"].(int))
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5088,12 +6721,12 @@ func (ec *executionContext) _Query_typeByTypeID(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Type)
+	res := resTmp.(int64)
 	fc.Result = res
-	return ec.marshalNType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_groupByGroupID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailEdge) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5101,24 +6734,17 @@ func (ec *executionContext) _Query_groupByGroupID(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailEdge",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_groupByGroupID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GroupByGroupID(rctx, args["id"].(int))
+		return obj.Cursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5130,12 +6756,12 @@ func (ec *executionContext) _Query_groupByGroupID(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.TypeGroup)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTypeGroup2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeGroup(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_categoryByGroupID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailEdge_node(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailEdge) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5143,24 +6769,17 @@ func (ec *executionContext) _Query_categoryByGroupID(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailEdge",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_categoryByGroupID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().CategoryByGroupID(rctx, args["id"].(int))
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5172,12 +6791,12 @@ func (ec *executionContext) _Query_categoryByGroupID(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.TypeCategory)
+	res := resTmp.(*neo.Killmail)
 	fc.Result = res
-	return ec.marshalNTypeCategory2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeCategory(ctx, field.Selections, res)
+	return ec.marshalNKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_solarSystemBySolarSystemID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_killmailID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5185,24 +6804,17 @@ func (ec *executionContext) _Query_solarSystemBySolarSystemID(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_solarSystemBySolarSystemID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().SolarSystemBySolarSystemID(rctx, args["id"].(int))
+		return obj.KillmailID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5214,12 +6826,12 @@ func (ec *executionContext) _Query_solarSystemBySolarSystemID(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.SolarSystem)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_constellationByConstellationID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_flag(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5227,24 +6839,17 @@ func (ec *executionContext) _Query_constellationByConstellationID(ctx context.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_constellationByConstellationID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().ConstellationByConstellationID(rctx, args["id"].(int))
+		return obj.Flag, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5256,12 +6861,12 @@ func (ec *executionContext) _Query_constellationByConstellationID(ctx context.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Constellation)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNConstellation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_regionByRegionID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_itemTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5269,24 +6874,17 @@ func (ec *executionContext) _Query_regionByRegionID(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_regionByRegionID_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().RegionByRegionID(rctx, args["id"].(int))
+		return obj.ItemTypeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5298,12 +6896,12 @@ func (ec *executionContext) _Query_regionByRegionID(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Region)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNRegion2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRegion(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_quantityDropped(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5311,24 +6909,17 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.QuantityDropped, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5337,12 +6928,12 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_quantityDestroyed(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5350,17 +6941,17 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.QuantityDestroyed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5369,12 +6960,12 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Region_id(ctx context.Context, field graphql.CollectedField, obj *neo.Region) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_singleton(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5382,7 +6973,7 @@ func (ec *executionContext) _Region_id(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Region",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5392,7 +6983,7 @@ func (ec *executionContext) _Region_id(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Singleton, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5404,12 +6995,12 @@ func (ec *executionContext) _Region_id(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(uint8)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNInt2uint8(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Region_name(ctx context.Context, field graphql.CollectedField, obj *neo.Region) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_isParent(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5417,7 +7008,7 @@ func (ec *executionContext) _Region_name(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Region",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5427,7 +7018,7 @@ func (ec *executionContext) _Region_name(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.IsParent, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5439,12 +7030,12 @@ func (ec *executionContext) _Region_name(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SolarSystem_id(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_itemValue(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5452,7 +7043,7 @@ func (ec *executionContext) _SolarSystem_id(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SolarSystem",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5462,7 +7053,7 @@ func (ec *executionContext) _SolarSystem_id(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.ItemValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5474,12 +7065,12 @@ func (ec *executionContext) _SolarSystem_id(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SolarSystem_name(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_totalValue(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5487,7 +7078,7 @@ func (ec *executionContext) _SolarSystem_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SolarSystem",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5497,7 +7088,7 @@ func (ec *executionContext) _SolarSystem_name(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.TotalValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5509,12 +7100,12 @@ func (ec *executionContext) _SolarSystem_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SolarSystem_regionID(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_type(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5522,34 +7113,31 @@ func (ec *executionContext) _SolarSystem_regionID(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SolarSystem",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RegionID, nil
+		return ec.resolvers.KillmailItem().Type(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(*neo.Type)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SolarSystem_constellationID(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_typeflag(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5557,34 +7145,31 @@ func (ec *executionContext) _SolarSystem_constellationID(ctx context.Context, fi
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SolarSystem",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ConstellationID, nil
+		return ec.resolvers.KillmailItem().Typeflag(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(*neo.TypeFlag)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalOTypeFlag2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeFlag(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SolarSystem_factionID(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailItem_items(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailItem) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5592,7 +7177,7 @@ func (ec *executionContext) _SolarSystem_factionID(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SolarSystem",
+		Object:     "KillmailItem",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5602,21 +7187,24 @@ func (ec *executionContext) _SolarSystem_factionID(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FactionID, nil
+		return obj.Items, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.([]*neo.KillmailItem)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SolarSystem_sunTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_killmailID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5624,7 +7212,7 @@ func (ec *executionContext) _SolarSystem_sunTypeID(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SolarSystem",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5634,21 +7222,24 @@ func (ec *executionContext) _SolarSystem_sunTypeID(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SunTypeID, nil
+		return obj.KillmailID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SolarSystem_security(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_allianceID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5656,7 +7247,7 @@ func (ec *executionContext) _SolarSystem_security(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SolarSystem",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5666,24 +7257,21 @@ func (ec *executionContext) _SolarSystem_security(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Security, nil
+		return obj.AllianceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(float64)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _SolarSystem_constellation(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_characterID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5691,76 +7279,66 @@ func (ec *executionContext) _SolarSystem_constellation(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "SolarSystem",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.SolarSystem().Constellation(rctx, obj)
+		return obj.CharacterID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.Constellation)
+	res := resTmp.(*uint64)
 	fc.Result = res
-	return ec.marshalNConstellation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Subscription_killmailFeed(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_corporationID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().KillmailFeed(rctx)
+		return obj.CorporationID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		return nil
-	}
-	return func() graphql.Marshaler {
-		res, ok := <-resTmp.(<-chan *neo.Killmail)
-		if !ok {
-			return nil
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.WriterFunc(func(w io.Writer) {
-			w.Write([]byte{'{'})
-			graphql.MarshalString(field.Alias).MarshalGQL(w)
-			w.Write([]byte{':'})
-			ec.marshalOKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res).MarshalGQL(w)
-			w.Write([]byte{'}'})
-		})
+		return graphql.Null
 	}
+	res := resTmp.(*uint)
+	fc.Result = res
+	return ec.marshalNInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Type_id(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_factionID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5768,7 +7346,7 @@ func (ec *executionContext) _Type_id(ctx context.Context, field graphql.Collecte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Type",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5778,24 +7356,21 @@ func (ec *executionContext) _Type_id(ctx context.Context, field graphql.Collecte
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.FactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(*uint)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Type_groupID(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_damageTaken(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5803,7 +7378,7 @@ func (ec *executionContext) _Type_groupID(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Type",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5813,7 +7388,7 @@ func (ec *executionContext) _Type_groupID(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.GroupID, nil
+		return obj.DamageTaken, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5830,7 +7405,7 @@ func (ec *executionContext) _Type_groupID(ctx context.Context, field graphql.Col
 	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Type_name(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_shipTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5838,7 +7413,7 @@ func (ec *executionContext) _Type_name(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Type",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5848,7 +7423,7 @@ func (ec *executionContext) _Type_name(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.ShipTypeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5860,12 +7435,12 @@ func (ec *executionContext) _Type_name(ctx context.Context, field graphql.Collec
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Type_description(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_shipValue(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5873,7 +7448,7 @@ func (ec *executionContext) _Type_description(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Type",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -5883,7 +7458,7 @@ func (ec *executionContext) _Type_description(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.ShipValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5895,12 +7470,12 @@ func (ec *executionContext) _Type_description(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Type_published(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5908,34 +7483,31 @@ func (ec *executionContext) _Type_published(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Type",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Published, nil
+		return ec.resolvers.KillmailVictim().Alliance(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*neo.Alliance)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Type_marketGroupID(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5943,17 +7515,17 @@ func (ec *executionContext) _Type_marketGroupID(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Type",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MarketGroupID, nil
+		return ec.resolvers.KillmailVictim().Corporation(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5962,12 +7534,12 @@ func (ec *executionContext) _Type_marketGroupID(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*uint)
+	res := resTmp.(*neo.Corporation)
 	fc.Result = res
-	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+	return ec.marshalOCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Type_group(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_character(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -5975,7 +7547,7 @@ func (ec *executionContext) _Type_group(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Type",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -5985,24 +7557,21 @@ func (ec *executionContext) _Type_group(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Type().Group(rctx, obj)
+		return ec.resolvers.KillmailVictim().Character(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.TypeGroup)
+	res := resTmp.(*neo.Character)
 	fc.Result = res
-	return ec.marshalNTypeGroup2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeGroup(ctx, field.Selections, res)
+	return ec.marshalOCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Type_attributes(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_ship(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6010,7 +7579,7 @@ func (ec *executionContext) _Type_attributes(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "Type",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -6020,24 +7589,21 @@ func (ec *executionContext) _Type_attributes(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Type().Attributes(rctx, obj)
+		return ec.resolvers.KillmailVictim().Ship(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*neo.TypeAttribute)
+	res := resTmp.(*neo.Type)
 	fc.Result = res
-	return ec.marshalNTypeAttribute2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeAttribute(ctx, field.Selections, res)
+	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeAttribute_typeID(ctx context.Context, field graphql.CollectedField, obj *neo.TypeAttribute) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_position(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6045,7 +7611,7 @@ func (ec *executionContext) _TypeAttribute_typeID(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeAttribute",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6055,24 +7621,21 @@ func (ec *executionContext) _TypeAttribute_typeID(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TypeID, nil
+		return obj.Position, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(*neo.Position)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalOPosition2ᚖgithubᚗcomᚋeveisesiᚋneoᚐPosition(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeAttribute_attributeID(ctx context.Context, field graphql.CollectedField, obj *neo.TypeAttribute) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_items(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6080,17 +7643,17 @@ func (ec *executionContext) _TypeAttribute_attributeID(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeAttribute",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AttributeID, nil
+		return ec.resolvers.KillmailVictim().Items(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6102,12 +7665,12 @@ func (ec *executionContext) _TypeAttribute_attributeID(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.([]*neo.KillmailItem)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeAttribute_value(ctx context.Context, field graphql.CollectedField, obj *neo.TypeAttribute) (ret graphql.Marshaler) {
+func (ec *executionContext) _KillmailVictim_fitted(ctx context.Context, field graphql.CollectedField, obj *neo.KillmailVictim) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6115,17 +7678,17 @@ func (ec *executionContext) _TypeAttribute_value(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeAttribute",
+		Object:     "KillmailVictim",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return ec.resolvers.KillmailVictim().Fitted(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6137,12 +7700,12 @@ func (ec *executionContext) _TypeAttribute_value(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int64)
+	res := resTmp.([]*neo.KillmailItem)
 	fc.Result = res
-	return ec.marshalNInt2int64(ctx, field.Selections, res)
+	return ec.marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeCategory_id(ctx context.Context, field graphql.CollectedField, obj *neo.TypeCategory) (ret graphql.Marshaler) {
+func (ec *executionContext) _Leaderboard_category(ctx context.Context, field graphql.CollectedField, obj *neo.Leaderboard) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6150,17 +7713,17 @@ func (ec *executionContext) _TypeCategory_id(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeCategory",
+		Object:     "Leaderboard",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Leaderboard().Category(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6172,12 +7735,12 @@ func (ec *executionContext) _TypeCategory_id(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(models.Entity)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeCategory_name(ctx context.Context, field graphql.CollectedField, obj *neo.TypeCategory) (ret graphql.Marshaler) {
+func (ec *executionContext) _Leaderboard_scope(ctx context.Context, field graphql.CollectedField, obj *neo.Leaderboard) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6185,17 +7748,17 @@ func (ec *executionContext) _TypeCategory_name(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeCategory",
+		Object:     "Leaderboard",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Leaderboard().Scope(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6207,12 +7770,12 @@ func (ec *executionContext) _TypeCategory_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(models.Entity)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeCategory_published(ctx context.Context, field graphql.CollectedField, obj *neo.TypeCategory) (ret graphql.Marshaler) {
+func (ec *executionContext) _Leaderboard_scopeID(ctx context.Context, field graphql.CollectedField, obj *neo.Leaderboard) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6220,7 +7783,7 @@ func (ec *executionContext) _TypeCategory_published(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeCategory",
+		Object:     "Leaderboard",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6230,24 +7793,21 @@ func (ec *executionContext) _TypeCategory_published(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Published, nil
+		return obj.ScopeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeFlag_id(ctx context.Context, field graphql.CollectedField, obj *neo.TypeFlag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Leaderboard_period(ctx context.Context, field graphql.CollectedField, obj *neo.Leaderboard) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6255,7 +7815,7 @@ func (ec *executionContext) _TypeFlag_id(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeFlag",
+		Object:     "Leaderboard",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6265,7 +7825,7 @@ func (ec *executionContext) _TypeFlag_id(ctx context.Context, field graphql.Coll
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Period, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6277,12 +7837,12 @@ func (ec *executionContext) _TypeFlag_id(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(neo.LeaderboardPeriod)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNLeaderboardPeriod2githubᚗcomᚋeveisesiᚋneoᚐLeaderboardPeriod(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeFlag_name(ctx context.Context, field graphql.CollectedField, obj *neo.TypeFlag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Leaderboard_metric(ctx context.Context, field graphql.CollectedField, obj *neo.Leaderboard) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6290,7 +7850,7 @@ func (ec *executionContext) _TypeFlag_name(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeFlag",
+		Object:     "Leaderboard",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6300,7 +7860,7 @@ func (ec *executionContext) _TypeFlag_name(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Metric, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6312,12 +7872,12 @@ func (ec *executionContext) _TypeFlag_name(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(neo.LeaderboardMetric)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLeaderboardMetric2githubᚗcomᚋeveisesiᚋneoᚐLeaderboardMetric(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeFlag_text(ctx context.Context, field graphql.CollectedField, obj *neo.TypeFlag) (ret graphql.Marshaler) {
+func (ec *executionContext) _Leaderboard_entries(ctx context.Context, field graphql.CollectedField, obj *neo.Leaderboard) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6325,7 +7885,7 @@ func (ec *executionContext) _TypeFlag_text(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeFlag",
+		Object:     "Leaderboard",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6335,7 +7895,7 @@ func (ec *executionContext) _TypeFlag_text(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Text, nil
+		return obj.Entries, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6347,12 +7907,12 @@ func (ec *executionContext) _TypeFlag_text(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*neo.LeaderboardEntry)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLeaderboardEntry2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardEntryᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeGroup_id(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+func (ec *executionContext) _Leaderboard_generatedAt(ctx context.Context, field graphql.CollectedField, obj *neo.Leaderboard) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6360,7 +7920,7 @@ func (ec *executionContext) _TypeGroup_id(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeGroup",
+		Object:     "Leaderboard",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6370,7 +7930,7 @@ func (ec *executionContext) _TypeGroup_id(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.GeneratedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6382,12 +7942,12 @@ func (ec *executionContext) _TypeGroup_id(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeGroup_categoryID(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+func (ec *executionContext) _LeaderboardEntry_id(ctx context.Context, field graphql.CollectedField, obj *neo.LeaderboardEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6395,7 +7955,7 @@ func (ec *executionContext) _TypeGroup_categoryID(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeGroup",
+		Object:     "LeaderboardEntry",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6405,7 +7965,7 @@ func (ec *executionContext) _TypeGroup_categoryID(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CategoryID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6417,12 +7977,12 @@ func (ec *executionContext) _TypeGroup_categoryID(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(uint)
+	res := resTmp.(uint64)
 	fc.Result = res
-	return ec.marshalNInt2uint(ctx, field.Selections, res)
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeGroup_name(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+func (ec *executionContext) _LeaderboardEntry_kills(ctx context.Context, field graphql.CollectedField, obj *neo.LeaderboardEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6430,7 +7990,7 @@ func (ec *executionContext) _TypeGroup_name(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeGroup",
+		Object:     "LeaderboardEntry",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6440,7 +8000,7 @@ func (ec *executionContext) _TypeGroup_name(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Kills, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6452,12 +8012,12 @@ func (ec *executionContext) _TypeGroup_name(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(uint)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeGroup_published(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+func (ec *executionContext) _LeaderboardEntry_iskDestroyed(ctx context.Context, field graphql.CollectedField, obj *neo.LeaderboardEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6465,7 +8025,7 @@ func (ec *executionContext) _TypeGroup_published(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeGroup",
+		Object:     "LeaderboardEntry",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6475,7 +8035,7 @@ func (ec *executionContext) _TypeGroup_published(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Published, nil
+		return obj.ISKDestroyed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6487,12 +8047,12 @@ func (ec *executionContext) _TypeGroup_published(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _TypeGroup_category(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+func (ec *executionContext) _LeaderboardEntry_character(ctx context.Context, field graphql.CollectedField, obj *neo.LeaderboardEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6500,7 +8060,7 @@ func (ec *executionContext) _TypeGroup_category(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "TypeGroup",
+		Object:     "LeaderboardEntry",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
@@ -6510,24 +8070,21 @@ func (ec *executionContext) _TypeGroup_category(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.TypeGroup().Category(rctx, obj)
+		return ec.resolvers.LeaderboardEntry().Character(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*neo.TypeCategory)
+	res := resTmp.(*neo.Character)
 	fc.Result = res
-	return ec.marshalNTypeCategory2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeCategory(ctx, field.Selections, res)
+	return ec.marshalOCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _LeaderboardEntry_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.LeaderboardEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6535,34 +8092,31 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "LeaderboardEntry",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.LeaderboardEntry().Corporation(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*neo.Corporation)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _LeaderboardEntry_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.LeaderboardEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6570,17 +8124,17 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "LeaderboardEntry",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return ec.resolvers.LeaderboardEntry().Alliance(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6589,12 +8143,12 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*neo.Alliance)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _LeaderboardEntry_type(ctx context.Context, field graphql.CollectedField, obj *neo.LeaderboardEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6602,34 +8156,31 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "LeaderboardEntry",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return ec.resolvers.LeaderboardEntry().Type(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*neo.Type)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+func (ec *executionContext) _LeaderboardEntry_solarSystem(ctx context.Context, field graphql.CollectedField, obj *neo.LeaderboardEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6637,34 +8188,31 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "LeaderboardEntry",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return ec.resolvers.LeaderboardEntry().SolarSystem(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*neo.SolarSystem)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_logout(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6672,17 +8220,17 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Mutation().Logout(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6694,12 +8242,12 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_watch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6707,31 +8255,41 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_watch_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return ec.resolvers.Mutation().Watch(rctx, args["entity"].(neo.WatchEntity), args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*neo.WatchlistEntry)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNWatchlistEntry2ᚖgithubᚗcomᚋeveisesiᚋneoᚐWatchlistEntry(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Mutation_unwatch(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6739,17 +8297,24 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Mutation",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_unwatch_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.Mutation().Unwatch(rctx, args["entity"].(neo.WatchEntity), args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6766,7 +8331,7 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *neo.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6774,31 +8339,34 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "PageInfo",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *neo.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6806,7 +8374,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PageInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6816,7 +8384,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.HasPreviousPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6828,12 +8396,12 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *neo.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6841,7 +8409,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PageInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6851,7 +8419,7 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.StartCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6860,12 +8428,12 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *neo.PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6873,7 +8441,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "PageInfo",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6883,24 +8451,21 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Position_x(ctx context.Context, field graphql.CollectedField, obj *neo.Position) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6908,7 +8473,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Position",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   false,
@@ -6918,24 +8483,21 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.X, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Position_y(ctx context.Context, field graphql.CollectedField, obj *neo.Position) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6943,34 +8505,31 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Position",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return obj.Y, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+func (ec *executionContext) _Position_z(ctx context.Context, field graphql.CollectedField, obj *neo.Position) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -6978,17 +8537,17 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Position",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return obj.Z, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6997,12 +8556,12 @@ func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_queryPlaceholder(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7010,17 +8569,17 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().QueryPlaceholder(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7032,12 +8591,12 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_allianceByAllianceID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7045,31 +8604,41 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_allianceByAllianceID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return ec.resolvers.Query().AllianceByAllianceID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		return graphql.Null
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*neo.Alliance)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_battleReports(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7077,17 +8646,24 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_battleReports_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Query().BattleReports(rctx, args["filter"].(models.BattleReportFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7099,12 +8675,12 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*neo.BattleReport)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBattleReport2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_battleReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7112,31 +8688,41 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_battleReport_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return ec.resolvers.Query().BattleReport(rctx, args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*neo.BattleReport)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBattleReport2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_characterByCharacterID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7144,17 +8730,24 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_characterByCharacterID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return ec.resolvers.Query().CharacterByCharacterID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7166,12 +8759,12 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*neo.Character)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_corporationByCorporationID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7179,17 +8772,24 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_corporationByCorporationID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return ec.resolvers.Query().CorporationByCorporationID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7201,12 +8801,12 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*neo.Corporation)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_killmail(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7214,31 +8814,41 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_killmail_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return ec.resolvers.Query().Killmail(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*neo.Killmail)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_killmailRecent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7246,31 +8856,41 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_killmailRecent_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return ec.resolvers.Query().KillmailRecent(rctx, args["page"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.([]*neo.Killmail)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_mvByEntityID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7278,17 +8898,24 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_mvByEntityID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return ec.resolvers.Query().MvByEntityID(rctx, args["category"].(*models.Category), args["entity"].(*models.Entity), args["id"].(*int), args["age"].(*int), args["limit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7300,12 +8927,12 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.([]*neo.Killmail)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_killmailsByEntityID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7313,17 +8940,24 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_killmailsByEntityID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return ec.resolvers.Query().KillmailsByEntityID(rctx, args["entity"].(models.Entity), args["id"].(int), args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string), args["filter"].(*models.KillmailFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7335,12 +8969,12 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*neo.KillmailConnection)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNKillmailConnection2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_leaderboard(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7348,31 +8982,41 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_leaderboard_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return ec.resolvers.Query().Leaderboard(rctx, args["category"].(models.Entity), args["scope"].(*models.Entity), args["scopeID"].(*int), args["period"].(*neo.LeaderboardPeriod), args["metric"].(*neo.LeaderboardMetric), args["limit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*neo.Leaderboard)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNLeaderboard2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboard(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_topShips(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7380,31 +9024,41 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_topShips_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Query().TopShips(rctx, args["entity"].(models.Entity), args["id"].(int), args["usage"].(neo.ShipUsage), args["grouping"].(*neo.ShipGrouping), args["period"].(*neo.StatPeriod), args["limit"].(*int), args["filter"].(*models.KillmailFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*neo.ShipCount)
 	fc.Result = res
-	return ec.marshalOString2string(ctx, field.Selections, res)
+	return ec.marshalNShipCount2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐShipCount(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_activity(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7412,16 +9066,16 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	args, err := ec.field_Query_activity_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7429,21 +9083,24 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Query().Activity(rctx, args["entity"].(models.Entity), args["id"].(int), args["days"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(*neo.Activity)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNActivity2ᚖgithubᚗcomᚋeveisesiᚋneoᚐActivity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_typeByTypeID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7451,31 +9108,41 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_typeByTypeID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return ec.resolvers.Query().TypeByTypeID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*neo.Type)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_groupByGroupID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7483,31 +9150,41 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_groupByGroupID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return ec.resolvers.Query().GroupByGroupID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*neo.TypeGroup)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNTypeGroup2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeGroup(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_categoryByGroupID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7515,16 +9192,16 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	args, err := ec.field_Query_categoryByGroupID_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -7532,21 +9209,24 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+		return ec.resolvers.Query().CategoryByGroupID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.(*neo.TypeCategory)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNTypeCategory2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeCategory(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_solarSystemBySolarSystemID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7554,31 +9234,41 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_solarSystemBySolarSystemID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return ec.resolvers.Query().SolarSystemBySolarSystemID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*neo.SolarSystem)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_constellationByConstellationID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -7586,421 +9276,5329 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "Query",
 		Field:      field,
 		Args:       nil,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_constellationByConstellationID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return ec.resolvers.Query().ConstellationByConstellationID(rctx, args["id"].(int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*neo.Constellation)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNConstellation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx, field.Selections, res)
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputBooleanFilterInput(ctx context.Context, obj interface{}) (models.BooleanFilterInput, error) {
-	var it models.BooleanFilterInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "eq":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
-			it.Eq, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "ne":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ne"))
-			it.Ne, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Query_regionByRegionID(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_regionByRegionID_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RegionByRegionID(rctx, args["id"].(int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Region)
+	fc.Result = res
+	return ec.marshalNRegion2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRegion(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputIntFilterInput(ctx context.Context, obj interface{}) (models.IntFilterInput, error) {
-	var it models.IntFilterInput
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "eq":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
-			it.Eq, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "ne":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ne"))
-			it.Ne, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "lt":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lt"))
-			it.Lt, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "gt":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gt"))
-			it.Gt, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "lte":
-			var err error
+func (ec *executionContext) _Query_me(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lte"))
-			it.Lte, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "gte":
-			var err error
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Me(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Viewer)
+	fc.Result = res
+	return ec.marshalOViewer2ᚖgithubᚗcomᚋeveisesiᚋneoᚐViewer(ctx, field.Selections, res)
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gte"))
-			it.Gte, err = ec.unmarshalOInt2ᚖint(ctx, v)
-			if err != nil {
-				return it, err
-			}
+func (ec *executionContext) _Query_watchlistKillmails(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
 	}
 
-	return it, nil
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_watchlistKillmails_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().WatchlistKillmails(rctx, args["first"].(*int), args["after"].(*string), args["last"].(*int), args["before"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*neo.KillmailConnection)
+	fc.Result = res
+	return ec.marshalNKillmailConnection2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailConnection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) unmarshalInputKillmailFilter(ctx context.Context, obj interface{}) (models.KillmailFilter, error) {
-	var it models.KillmailFilter
-	var asMap = obj.(map[string]interface{})
-
-	for k, v := range asMap {
-		switch k {
-		case "moonID":
-			var err error
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moonID"))
-			it.MoonID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectType(args["name"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.introspectSchema()
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Schema)
+	fc.Result = res
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Region_id(ctx context.Context, field graphql.CollectedField, obj *neo.Region) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Region",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Region_name(ctx context.Context, field graphql.CollectedField, obj *neo.Region) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Region",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelatedKillmails_allianceID(ctx context.Context, field graphql.CollectedField, obj *neo.RelatedKillmails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelatedKillmails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AllianceID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*uint)
+	fc.Result = res
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelatedKillmails_totalValue(ctx context.Context, field graphql.CollectedField, obj *neo.RelatedKillmails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelatedKillmails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelatedKillmails_killmails(ctx context.Context, field graphql.CollectedField, obj *neo.RelatedKillmails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelatedKillmails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Killmails, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*neo.Killmail)
+	fc.Result = res
+	return ec.marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RelatedKillmails_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.RelatedKillmails) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "RelatedKillmails",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.RelatedKillmails().Alliance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Alliance)
+	fc.Result = res
+	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ShipCount_id(ctx context.Context, field graphql.CollectedField, obj *neo.ShipCount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ShipCount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ShipCount_grouping(ctx context.Context, field graphql.CollectedField, obj *neo.ShipCount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ShipCount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Grouping, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(neo.ShipGrouping)
+	fc.Result = res
+	return ec.marshalNShipGrouping2githubᚗcomᚋeveisesiᚋneoᚐShipGrouping(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ShipCount_killmails(ctx context.Context, field graphql.CollectedField, obj *neo.ShipCount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ShipCount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Killmails, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ShipCount_totalValue(ctx context.Context, field graphql.CollectedField, obj *neo.ShipCount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ShipCount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ShipCount_type(ctx context.Context, field graphql.CollectedField, obj *neo.ShipCount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ShipCount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ShipCount().Type(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Type)
+	fc.Result = res
+	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ShipCount_group(ctx context.Context, field graphql.CollectedField, obj *neo.ShipCount) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "ShipCount",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.ShipCount().Group(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.TypeGroup)
+	fc.Result = res
+	return ec.marshalOTypeGroup2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SolarSystem_id(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SolarSystem",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SolarSystem_name(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SolarSystem",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SolarSystem_regionID(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SolarSystem",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RegionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SolarSystem_constellationID(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SolarSystem",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConstellationID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SolarSystem_factionID(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SolarSystem",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FactionID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SolarSystem_sunTypeID(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SolarSystem",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SunTypeID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SolarSystem_security(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SolarSystem",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Security, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _SolarSystem_constellation(ctx context.Context, field graphql.CollectedField, obj *neo.SolarSystem) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "SolarSystem",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.SolarSystem().Constellation(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Constellation)
+	fc.Result = res
+	return ec.marshalNConstellation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Subscription_killmailFeed(ctx context.Context, field graphql.CollectedField) (ret func() graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Subscription_killmailFeed_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().KillmailFeed(rctx, args["filter"].(*models.KillmailFeedFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		return nil
+	}
+	return func() graphql.Marshaler {
+		res, ok := <-resTmp.(<-chan *neo.Killmail)
+		if !ok {
+			return nil
+		}
+		return graphql.WriterFunc(func(w io.Writer) {
+			w.Write([]byte{'{'})
+			graphql.MarshalString(field.Alias).MarshalGQL(w)
+			w.Write([]byte{':'})
+			ec.marshalOKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, field.Selections, res).MarshalGQL(w)
+			w.Write([]byte{'}'})
+		})
+	}
+}
+
+func (ec *executionContext) _Type_id(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Type_groupID(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.GroupID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Type_name(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Type_description(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Type_published(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Published, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Type_marketGroupID(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MarketGroupID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*uint)
+	fc.Result = res
+	return ec.marshalOInt2ᚖuint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Type_group(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Type().Group(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*neo.TypeGroup)
+	fc.Result = res
+	return ec.marshalNTypeGroup2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeGroup(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Type_attributes(ctx context.Context, field graphql.CollectedField, obj *neo.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Type().Attributes(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*neo.TypeAttribute)
+	fc.Result = res
+	return ec.marshalNTypeAttribute2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeAttribute(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeAttribute_typeID(ctx context.Context, field graphql.CollectedField, obj *neo.TypeAttribute) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeAttribute",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TypeID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeAttribute_attributeID(ctx context.Context, field graphql.CollectedField, obj *neo.TypeAttribute) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeAttribute",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AttributeID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeAttribute_value(ctx context.Context, field graphql.CollectedField, obj *neo.TypeAttribute) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeAttribute",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt2int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeCategory_id(ctx context.Context, field graphql.CollectedField, obj *neo.TypeCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeCategory_name(ctx context.Context, field graphql.CollectedField, obj *neo.TypeCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeCategory_published(ctx context.Context, field graphql.CollectedField, obj *neo.TypeCategory) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeCategory",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Published, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeFlag_id(ctx context.Context, field graphql.CollectedField, obj *neo.TypeFlag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeFlag",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeFlag_name(ctx context.Context, field graphql.CollectedField, obj *neo.TypeFlag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeFlag",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeFlag_text(ctx context.Context, field graphql.CollectedField, obj *neo.TypeFlag) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeFlag",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Text, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeGroup_id(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeGroup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeGroup_categoryID(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeGroup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CategoryID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint)
+	fc.Result = res
+	return ec.marshalNInt2uint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeGroup_name(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeGroup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeGroup_published(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeGroup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Published, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _TypeGroup_category(ctx context.Context, field graphql.CollectedField, obj *neo.TypeGroup) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "TypeGroup",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.TypeGroup().Category(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*neo.TypeCategory)
+	fc.Result = res
+	return ec.marshalNTypeCategory2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeCategory(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_characterID(ctx context.Context, field graphql.CollectedField, obj *neo.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Viewer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CharacterID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint64)
+	fc.Result = res
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_character(ctx context.Context, field graphql.CollectedField, obj *neo.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Viewer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Viewer().Character(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Character)
+	fc.Result = res
+	return ec.marshalNCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Viewer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Viewer().Corporation(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Corporation)
+	fc.Result = res
+	return ec.marshalNCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Viewer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Viewer().Alliance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Alliance)
+	fc.Result = res
+	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_characters(ctx context.Context, field graphql.CollectedField, obj *neo.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Viewer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Viewer().Characters(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*neo.Character)
+	fc.Result = res
+	return ec.marshalNCharacter2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Viewer_watchlist(ctx context.Context, field graphql.CollectedField, obj *neo.Viewer) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "Viewer",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Viewer().Watchlist(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*neo.WatchlistEntry)
+	fc.Result = res
+	return ec.marshalNWatchlistEntry2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐWatchlistEntry(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WatchlistEntry_entity(ctx context.Context, field graphql.CollectedField, obj *neo.WatchlistEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WatchlistEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Entity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(neo.WatchEntity)
+	fc.Result = res
+	return ec.marshalNWatchEntity2githubᚗcomᚋeveisesiᚋneoᚐWatchEntity(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WatchlistEntry_entityID(ctx context.Context, field graphql.CollectedField, obj *neo.WatchlistEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WatchlistEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EntityID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(uint64)
+	fc.Result = res
+	return ec.marshalNInt2uint64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WatchlistEntry_createdAt(ctx context.Context, field graphql.CollectedField, obj *neo.WatchlistEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WatchlistEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(time.Time)
+	fc.Result = res
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WatchlistEntry_character(ctx context.Context, field graphql.CollectedField, obj *neo.WatchlistEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WatchlistEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.WatchlistEntry().Character(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Character)
+	fc.Result = res
+	return ec.marshalOCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WatchlistEntry_corporation(ctx context.Context, field graphql.CollectedField, obj *neo.WatchlistEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WatchlistEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.WatchlistEntry().Corporation(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Corporation)
+	fc.Result = res
+	return ec.marshalOCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WatchlistEntry_alliance(ctx context.Context, field graphql.CollectedField, obj *neo.WatchlistEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WatchlistEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.WatchlistEntry().Alliance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Alliance)
+	fc.Result = res
+	return ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WatchlistEntry_system(ctx context.Context, field graphql.CollectedField, obj *neo.WatchlistEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WatchlistEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.WatchlistEntry().System(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.SolarSystem)
+	fc.Result = res
+	return ec.marshalOSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _WatchlistEntry_ship(ctx context.Context, field graphql.CollectedField, obj *neo.WatchlistEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "WatchlistEntry",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.WatchlistEntry().Ship(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*neo.Type)
+	fc.Result = res
+	return ec.marshalOType2ᚖgithubᚗcomᚋeveisesiᚋneoᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   false,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalOString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_fields_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field___Type_enumValues_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		Args:       nil,
+		IsMethod:   true,
+		IsResolver: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputBattleReportFilter(ctx context.Context, obj interface{}) (models.BattleReportFilter, error) {
+	var it models.BattleReportFilter
+	var asMap = obj.(map[string]interface{})
+
+	if _, present := asMap["scope"]; !present {
+		asMap["scope"] = "system"
+	}
+
+	for k, v := range asMap {
+		switch k {
+		case "from":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("from"))
+			it.From, err = ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "to":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("to"))
+			it.To, err = ec.unmarshalNTime2timeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "scope":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("scope"))
+			it.Scope, err = ec.unmarshalOBattleScope2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleScope(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "solarSystemID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("solarSystemID"))
+			it.SolarSystemID, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "constellationID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("constellationID"))
+			it.ConstellationID, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "regionID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("regionID"))
+			it.RegionID, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "window":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("window"))
+			it.Window, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "minKillmails":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minKillmails"))
+			it.MinKillmails, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputBooleanFilterInput(ctx context.Context, obj interface{}) (models.BooleanFilterInput, error) {
+	var it models.BooleanFilterInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "eq":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
+			it.Eq, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "ne":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ne"))
+			it.Ne, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputIntFilterInput(ctx context.Context, obj interface{}) (models.IntFilterInput, error) {
+	var it models.IntFilterInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "eq":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
+			it.Eq, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "ne":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ne"))
+			it.Ne, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lt"))
+			it.Lt, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gt"))
+			it.Gt, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lte":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lte"))
+			it.Lte, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gte":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gte"))
+			it.Gte, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputKillmailFeedFilter(ctx context.Context, obj interface{}) (models.KillmailFeedFilter, error) {
+	var it models.KillmailFeedFilter
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "characterIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("characterIDs"))
+			it.CharacterIDs, err = ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "corporationIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("corporationIDs"))
+			it.CorporationIDs, err = ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "allianceIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allianceIDs"))
+			it.AllianceIDs, err = ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "side":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("side"))
+			it.Side, err = ec.unmarshalOKillmailSide2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailSide(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "regionIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("regionIDs"))
+			it.RegionIDs, err = ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "solarSystemIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("solarSystemIDs"))
+			it.SolarSystemIDs, err = ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "shipGroupIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shipGroupIDs"))
+			it.ShipGroupIDs, err = ec.unmarshalOInt2ᚕintᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "minTotalValue":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minTotalValue"))
+			it.MinTotalValue, err = ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isSolo":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isSolo"))
+			it.IsSolo, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isNPC":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isNPC"))
+			it.IsNpc, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isAwox":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isAwox"))
+			it.IsAwox, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputKillmailFilter(ctx context.Context, obj interface{}) (models.KillmailFilter, error) {
+	var it models.KillmailFilter
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "moonID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("moonID"))
+			it.MoonID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "solarSystemID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("solarSystemID"))
+			it.SolarSystemID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "warID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("warID"))
+			it.WarID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isNPC":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isNPC"))
+			it.IsNpc, err = ec.unmarshalOBooleanFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBooleanFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isAwox":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isAwox"))
+			it.IsAwox, err = ec.unmarshalOBooleanFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBooleanFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "isSolo":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isSolo"))
+			it.IsSolo, err = ec.unmarshalOBooleanFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBooleanFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "tags":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tags"))
+			it.Tags, err = ec.unmarshalOTagFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐTagFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "droppedValue":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("droppedValue"))
+			it.DroppedValue, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "destroyedValue":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("destroyedValue"))
+			it.DestroyedValue, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "fittedValue":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fittedValue"))
+			it.FittedValue, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "totalValue":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("totalValue"))
+			it.TotalValue, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attackersAllianceID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersAllianceID"))
+			it.AttackersAllianceID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attackersCorporationID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersCorporationID"))
+			it.AttackersCorporationID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attackersCharacterID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersCharacterID"))
+			it.AttackersCharacterID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attackersFactionID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersFactionID"))
+			it.AttackersFactionID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attackersDamageDone":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersDamageDone"))
+			it.AttackersDamageDone, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attackersFinalBlow":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersFinalBlow"))
+			it.AttackersFinalBlow, err = ec.unmarshalOBooleanFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBooleanFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attackersShipTypeID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersShipTypeID"))
+			it.AttackersShipTypeID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "attackersWeaponTypeID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersWeaponTypeID"))
+			it.AttackersWeaponTypeID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "victimAllianceID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimAllianceID"))
+			it.VictimAllianceID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "victimCorporationID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimCorporationID"))
+			it.VictimCorporationID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "victimCharacterID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimCharacterID"))
+			it.VictimCharacterID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "victimFactionID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimFactionID"))
+			it.VictimFactionID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "victimDamageTaken":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimDamageTaken"))
+			it.VictimDamageTaken, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "victimShiptypeID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimShiptypeID"))
+			it.VictimShiptypeID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTagFilterInput(ctx context.Context, obj interface{}) (models.TagFilterInput, error) {
+	var it models.TagFilterInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "in":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("in"))
+			it.In, err = ec.unmarshalOKillmailTag2ᚕgithubᚗcomᚋeveisesiᚋneoᚐKillmailTagᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "nin":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("nin"))
+			it.Nin, err = ec.unmarshalOKillmailTag2ᚕgithubᚗcomᚋeveisesiᚋneoᚐKillmailTagᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTimeFilterInput(ctx context.Context, obj interface{}) (models.TimeFilterInput, error) {
+	var it models.TimeFilterInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "eq":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
+			it.Eq, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "ne":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ne"))
+			it.Ne, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lt"))
+			it.Lt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gt":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gt"))
+			it.Gt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lte":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lte"))
+			it.Lte, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "gte":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gte"))
+			it.Gte, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var activityImplementors = []string{"Activity"}
+
+func (ec *executionContext) _Activity(ctx context.Context, sel ast.SelectionSet, obj *neo.Activity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, activityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Activity")
+		case "days":
+			out.Values[i] = ec._Activity_days(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "kills":
+			out.Values[i] = ec._Activity_kills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "losses":
+			out.Values[i] = ec._Activity_losses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var allianceImplementors = []string{"Alliance"}
+
+func (ec *executionContext) _Alliance(ctx context.Context, sel ast.SelectionSet, obj *neo.Alliance) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, allianceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Alliance")
+		case "id":
+			out.Values[i] = ec._Alliance_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Alliance_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "ticker":
+			out.Values[i] = ec._Alliance_ticker(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "memberCount":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Alliance_memberCount(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "stats":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Alliance_stats(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var battleReportImplementors = []string{"BattleReport"}
+
+func (ec *executionContext) _BattleReport(ctx context.Context, sel ast.SelectionSet, obj *neo.BattleReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, battleReportImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BattleReport")
+		case "id":
+			out.Values[i] = ec._BattleReport_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "scope":
+			out.Values[i] = ec._BattleReport_scope(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "locationID":
+			out.Values[i] = ec._BattleReport_locationID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "startTime":
+			out.Values[i] = ec._BattleReport_startTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "endTime":
+			out.Values[i] = ec._BattleReport_endTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "totalValue":
+			out.Values[i] = ec._BattleReport_totalValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "killmails":
+			out.Values[i] = ec._BattleReport_killmails(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "sides":
+			out.Values[i] = ec._BattleReport_sides(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "system":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BattleReport_system(ctx, field, obj)
+				return res
+			})
+		case "constellation":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BattleReport_constellation(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var battleSideImplementors = []string{"BattleSide"}
+
+func (ec *executionContext) _BattleSide(ctx context.Context, sel ast.SelectionSet, obj *neo.BattleSide) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, battleSideImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BattleSide")
+		case "allianceIDs":
+			out.Values[i] = ec._BattleSide_allianceIDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "corporationIDs":
+			out.Values[i] = ec._BattleSide_corporationIDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "pilots":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BattleSide_pilots(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "iskKilled":
+			out.Values[i] = ec._BattleSide_iskKilled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "iskLost":
+			out.Values[i] = ec._BattleSide_iskLost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "shipsKilled":
+			out.Values[i] = ec._BattleSide_shipsKilled(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "shipsLost":
+			out.Values[i] = ec._BattleSide_shipsLost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "efficiency":
+			out.Values[i] = ec._BattleSide_efficiency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "alliances":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BattleSide_alliances(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "corporations":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._BattleSide_corporations(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var characterImplementors = []string{"Character"}
+
+func (ec *executionContext) _Character(ctx context.Context, sel ast.SelectionSet, obj *neo.Character) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, characterImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Character")
+		case "id":
+			out.Values[i] = ec._Character_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Character_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "securityStatus":
+			out.Values[i] = ec._Character_securityStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "corporation":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Character_corporation(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "stats":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Character_stats(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var constellationImplementors = []string{"Constellation"}
+
+func (ec *executionContext) _Constellation(ctx context.Context, sel ast.SelectionSet, obj *neo.Constellation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, constellationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Constellation")
+		case "id":
+			out.Values[i] = ec._Constellation_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Constellation_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "regionID":
+			out.Values[i] = ec._Constellation_regionID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "factionID":
+			out.Values[i] = ec._Constellation_factionID(ctx, field, obj)
+		case "region":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Constellation_region(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var corporationImplementors = []string{"Corporation"}
+
+func (ec *executionContext) _Corporation(ctx context.Context, sel ast.SelectionSet, obj *neo.Corporation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, corporationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Corporation")
+		case "id":
+			out.Values[i] = ec._Corporation_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Corporation_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "ticker":
+			out.Values[i] = ec._Corporation_ticker(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "memberCount":
+			out.Values[i] = ec._Corporation_memberCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "alliance":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Corporation_alliance(ctx, field, obj)
+				return res
+			})
+		case "stats":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Corporation_stats(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var entityStatsImplementors = []string{"EntityStats"}
+
+func (ec *executionContext) _EntityStats(ctx context.Context, sel ast.SelectionSet, obj *neo.EntityStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, entityStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EntityStats")
+		case "period":
+			out.Values[i] = ec._EntityStats_period(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "kills":
+			out.Values[i] = ec._EntityStats_kills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "losses":
+			out.Values[i] = ec._EntityStats_losses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "iskDestroyed":
+			out.Values[i] = ec._EntityStats_iskDestroyed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "iskLost":
+			out.Values[i] = ec._EntityStats_iskLost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "iskEfficiency":
+			out.Values[i] = ec._EntityStats_iskEfficiency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "soloKills":
+			out.Values[i] = ec._EntityStats_soloKills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "dangerRatio":
+			out.Values[i] = ec._EntityStats_dangerRatio(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "averageGangSize":
+			out.Values[i] = ec._EntityStats_averageGangSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var killmailImplementors = []string{"Killmail"}
+
+func (ec *executionContext) _Killmail(ctx context.Context, sel ast.SelectionSet, obj *neo.Killmail) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, killmailImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Killmail")
+		case "id":
+			out.Values[i] = ec._Killmail_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
+		case "hash":
+			out.Values[i] = ec._Killmail_hash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "moonID":
+			out.Values[i] = ec._Killmail_moonID(ctx, field, obj)
 		case "solarSystemID":
-			var err error
+			out.Values[i] = ec._Killmail_solarSystemID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "warID":
+			out.Values[i] = ec._Killmail_warID(ctx, field, obj)
+		case "isNPC":
+			out.Values[i] = ec._Killmail_isNPC(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isAwox":
+			out.Values[i] = ec._Killmail_isAwox(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isSolo":
+			out.Values[i] = ec._Killmail_isSolo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "droppedValue":
+			out.Values[i] = ec._Killmail_droppedValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "destroyedValue":
+			out.Values[i] = ec._Killmail_destroyedValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "fittedValue":
+			out.Values[i] = ec._Killmail_fittedValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "totalValue":
+			out.Values[i] = ec._Killmail_totalValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "killmailTime":
+			out.Values[i] = ec._Killmail_killmailTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "tags":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Killmail_tags(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "system":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Killmail_system(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "attackers":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Killmail_attackers(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "victim":
+			out.Values[i] = ec._Killmail_victim(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "related":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Killmail_related(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var killmailAttackerImplementors = []string{"KillmailAttacker"}
+
+func (ec *executionContext) _KillmailAttacker(ctx context.Context, sel ast.SelectionSet, obj *neo.KillmailAttacker) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, killmailAttackerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KillmailAttacker")
+		case "killmailID":
+			out.Values[i] = ec._KillmailAttacker_killmailID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "allianceID":
+			out.Values[i] = ec._KillmailAttacker_allianceID(ctx, field, obj)
+		case "characterID":
+			out.Values[i] = ec._KillmailAttacker_characterID(ctx, field, obj)
+		case "corporationID":
+			out.Values[i] = ec._KillmailAttacker_corporationID(ctx, field, obj)
+		case "factionID":
+			out.Values[i] = ec._KillmailAttacker_factionID(ctx, field, obj)
+		case "damageDone":
+			out.Values[i] = ec._KillmailAttacker_damageDone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "finalBlow":
+			out.Values[i] = ec._KillmailAttacker_finalBlow(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "securityStatus":
+			out.Values[i] = ec._KillmailAttacker_securityStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "shipTypeID":
+			out.Values[i] = ec._KillmailAttacker_shipTypeID(ctx, field, obj)
+		case "weaponTypeID":
+			out.Values[i] = ec._KillmailAttacker_weaponTypeID(ctx, field, obj)
+		case "alliance":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailAttacker_alliance(ctx, field, obj)
+				return res
+			})
+		case "corporation":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailAttacker_corporation(ctx, field, obj)
+				return res
+			})
+		case "character":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailAttacker_character(ctx, field, obj)
+				return res
+			})
+		case "ship":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailAttacker_ship(ctx, field, obj)
+				return res
+			})
+		case "weapon":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailAttacker_weapon(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var killmailConnectionImplementors = []string{"KillmailConnection"}
+
+func (ec *executionContext) _KillmailConnection(ctx context.Context, sel ast.SelectionSet, obj *neo.KillmailConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, killmailConnectionImplementors)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("solarSystemID"))
-			it.SolarSystemID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KillmailConnection")
+		case "edges":
+			out.Values[i] = ec._KillmailConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "warID":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("warID"))
-			it.WarID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "pageInfo":
+			out.Values[i] = ec._KillmailConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "isNPC":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isNPC"))
-			it.IsNpc, err = ec.unmarshalOBooleanFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBooleanFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "totalCount":
+			out.Values[i] = ec._KillmailConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "isAwox":
-			var err error
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isAwox"))
-			it.IsAwox, err = ec.unmarshalOBooleanFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBooleanFilterInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "isSolo":
-			var err error
+var killmailEdgeImplementors = []string{"KillmailEdge"}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isSolo"))
-			it.IsSolo, err = ec.unmarshalOBooleanFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBooleanFilterInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "droppedValue":
-			var err error
+func (ec *executionContext) _KillmailEdge(ctx context.Context, sel ast.SelectionSet, obj *neo.KillmailEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, killmailEdgeImplementors)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("droppedValue"))
-			it.DroppedValue, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KillmailEdge")
+		case "cursor":
+			out.Values[i] = ec._KillmailEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "destroyedValue":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("destroyedValue"))
-			it.DestroyedValue, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "node":
+			out.Values[i] = ec._KillmailEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "fittedValue":
-			var err error
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fittedValue"))
-			it.FittedValue, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+var killmailItemImplementors = []string{"KillmailItem"}
+
+func (ec *executionContext) _KillmailItem(ctx context.Context, sel ast.SelectionSet, obj *neo.KillmailItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, killmailItemImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KillmailItem")
+		case "killmailID":
+			out.Values[i] = ec._KillmailItem_killmailID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "flag":
+			out.Values[i] = ec._KillmailItem_flag(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "itemTypeID":
+			out.Values[i] = ec._KillmailItem_itemTypeID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "quantityDropped":
+			out.Values[i] = ec._KillmailItem_quantityDropped(ctx, field, obj)
+		case "quantityDestroyed":
+			out.Values[i] = ec._KillmailItem_quantityDestroyed(ctx, field, obj)
+		case "singleton":
+			out.Values[i] = ec._KillmailItem_singleton(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "isParent":
+			out.Values[i] = ec._KillmailItem_isParent(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "itemValue":
+			out.Values[i] = ec._KillmailItem_itemValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
 		case "totalValue":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("totalValue"))
-			it.TotalValue, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._KillmailItem_totalValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "attackersAllianceID":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersAllianceID"))
-			it.AttackersAllianceID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "type":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailItem_type(ctx, field, obj)
+				return res
+			})
+		case "typeflag":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailItem_typeflag(ctx, field, obj)
+				return res
+			})
+		case "items":
+			out.Values[i] = ec._KillmailItem_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "attackersCorporationID":
-			var err error
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersCorporationID"))
-			it.AttackersCorporationID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "attackersCharacterID":
-			var err error
+var killmailVictimImplementors = []string{"KillmailVictim"}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersCharacterID"))
-			it.AttackersCharacterID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _KillmailVictim(ctx context.Context, sel ast.SelectionSet, obj *neo.KillmailVictim) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, killmailVictimImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KillmailVictim")
+		case "killmailID":
+			out.Values[i] = ec._KillmailVictim_killmailID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "attackersFactionID":
-			var err error
+		case "allianceID":
+			out.Values[i] = ec._KillmailVictim_allianceID(ctx, field, obj)
+		case "characterID":
+			out.Values[i] = ec._KillmailVictim_characterID(ctx, field, obj)
+		case "corporationID":
+			out.Values[i] = ec._KillmailVictim_corporationID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "factionID":
+			out.Values[i] = ec._KillmailVictim_factionID(ctx, field, obj)
+		case "damageTaken":
+			out.Values[i] = ec._KillmailVictim_damageTaken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "shipTypeID":
+			out.Values[i] = ec._KillmailVictim_shipTypeID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "shipValue":
+			out.Values[i] = ec._KillmailVictim_shipValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "alliance":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailVictim_alliance(ctx, field, obj)
+				return res
+			})
+		case "corporation":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailVictim_corporation(ctx, field, obj)
+				return res
+			})
+		case "character":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailVictim_character(ctx, field, obj)
+				return res
+			})
+		case "ship":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailVictim_ship(ctx, field, obj)
+				return res
+			})
+		case "position":
+			out.Values[i] = ec._KillmailVictim_position(ctx, field, obj)
+		case "items":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailVictim_items(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "fitted":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._KillmailVictim_fitted(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersFactionID"))
-			it.AttackersFactionID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "attackersDamageDone":
-			var err error
+var leaderboardImplementors = []string{"Leaderboard"}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersDamageDone"))
-			it.AttackersDamageDone, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "attackersFinalBlow":
-			var err error
+func (ec *executionContext) _Leaderboard(ctx context.Context, sel ast.SelectionSet, obj *neo.Leaderboard) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, leaderboardImplementors)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersFinalBlow"))
-			it.AttackersFinalBlow, err = ec.unmarshalOBooleanFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBooleanFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Leaderboard")
+		case "category":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Leaderboard_category(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "scope":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Leaderboard_scope(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "scopeID":
+			out.Values[i] = ec._Leaderboard_scopeID(ctx, field, obj)
+		case "period":
+			out.Values[i] = ec._Leaderboard_period(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "attackersShipTypeID":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersShipTypeID"))
-			it.AttackersShipTypeID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "metric":
+			out.Values[i] = ec._Leaderboard_metric(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "attackersWeaponTypeID":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("attackersWeaponTypeID"))
-			it.AttackersWeaponTypeID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "entries":
+			out.Values[i] = ec._Leaderboard_entries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "victimAllianceID":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimAllianceID"))
-			it.VictimAllianceID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "generatedAt":
+			out.Values[i] = ec._Leaderboard_generatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "victimCorporationID":
-			var err error
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimCorporationID"))
-			it.VictimCorporationID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "victimCharacterID":
-			var err error
+var leaderboardEntryImplementors = []string{"LeaderboardEntry"}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimCharacterID"))
-			it.VictimCharacterID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "victimFactionID":
-			var err error
+func (ec *executionContext) _LeaderboardEntry(ctx context.Context, sel ast.SelectionSet, obj *neo.LeaderboardEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, leaderboardEntryImplementors)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimFactionID"))
-			it.VictimFactionID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LeaderboardEntry")
+		case "id":
+			out.Values[i] = ec._LeaderboardEntry_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "victimDamageTaken":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimDamageTaken"))
-			it.VictimDamageTaken, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "kills":
+			out.Values[i] = ec._LeaderboardEntry_kills(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
-		case "victimShiptypeID":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("victimShiptypeID"))
-			it.VictimShiptypeID, err = ec.unmarshalOIntFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐIntFilterInput(ctx, v)
-			if err != nil {
-				return it, err
+		case "iskDestroyed":
+			out.Values[i] = ec._LeaderboardEntry_iskDestroyed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
 			}
+		case "character":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LeaderboardEntry_character(ctx, field, obj)
+				return res
+			})
+		case "corporation":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LeaderboardEntry_corporation(ctx, field, obj)
+				return res
+			})
+		case "alliance":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LeaderboardEntry_alliance(ctx, field, obj)
+				return res
+			})
+		case "type":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LeaderboardEntry_type(ctx, field, obj)
+				return res
+			})
+		case "solarSystem":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LeaderboardEntry_solarSystem(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-func (ec *executionContext) unmarshalInputTimeFilterInput(ctx context.Context, obj interface{}) (models.TimeFilterInput, error) {
-	var it models.TimeFilterInput
-	var asMap = obj.(map[string]interface{})
+var mutationImplementors = []string{"Mutation"}
 
-	for k, v := range asMap {
-		switch k {
-		case "eq":
-			var err error
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
-			it.Eq, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "ne":
-			var err error
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("ne"))
-			it.Ne, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "logout":
+			out.Values[i] = ec._Mutation_logout(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "lt":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lt"))
-			it.Lt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+		case "watch":
+			out.Values[i] = ec._Mutation_watch(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "gt":
-			var err error
-
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gt"))
-			it.Gt, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+		case "unwatch":
+			out.Values[i] = ec._Mutation_unwatch(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
-		case "lte":
-			var err error
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lte"))
-			it.Lte, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
-			}
-		case "gte":
-			var err error
+var pageInfoImplementors = []string{"PageInfo"}
 
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gte"))
-			it.Gte, err = ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *neo.PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
 }
 
-// endregion **************************** input.gotpl *****************************
+var positionImplementors = []string{"Position"}
 
-// region    ************************** interface.gotpl ***************************
+func (ec *executionContext) _Position(ctx context.Context, sel ast.SelectionSet, obj *neo.Position) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, positionImplementors)
 
-// endregion ************************** interface.gotpl ***************************
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Position")
+		case "x":
+			out.Values[i] = ec._Position_x(ctx, field, obj)
+		case "y":
+			out.Values[i] = ec._Position_y(ctx, field, obj)
+		case "z":
+			out.Values[i] = ec._Position_z(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
 
-// region    **************************** object.gotpl ****************************
+var queryImplementors = []string{"Query"}
 
-var allianceImplementors = []string{"Alliance"}
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
 
-func (ec *executionContext) _Alliance(ctx context.Context, sel ast.SelectionSet, obj *neo.Alliance) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, allianceImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Alliance")
-		case "id":
-			out.Values[i] = ec._Alliance_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._Alliance_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "ticker":
-			out.Values[i] = ec._Alliance_ticker(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "memberCount":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "queryPlaceholder":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_queryPlaceholder(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "allianceByAllianceID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_allianceByAllianceID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "battleReports":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_battleReports(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "battleReport":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_battleReport(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "characterByCharacterID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_characterByCharacterID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "corporationByCorporationID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_corporationByCorporationID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "killmail":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_killmail(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "killmailRecent":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_killmailRecent(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "mvByEntityID":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8008,50 +14606,13 @@ func (ec *executionContext) _Alliance(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Alliance_memberCount(ctx, field, obj)
+				res = ec._Query_mvByEntityID(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var characterImplementors = []string{"Character"}
-
-func (ec *executionContext) _Character(ctx context.Context, sel ast.SelectionSet, obj *neo.Character) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, characterImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Character")
-		case "id":
-			out.Values[i] = ec._Character_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._Character_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "securityStatus":
-			out.Values[i] = ec._Character_securityStatus(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "corporation":
+		case "killmailsByEntityID":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8059,52 +14620,13 @@ func (ec *executionContext) _Character(ctx context.Context, sel ast.SelectionSet
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Character_corporation(ctx, field, obj)
+				res = ec._Query_killmailsByEntityID(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var constellationImplementors = []string{"Constellation"}
-
-func (ec *executionContext) _Constellation(ctx context.Context, sel ast.SelectionSet, obj *neo.Constellation) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, constellationImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Constellation")
-		case "id":
-			out.Values[i] = ec._Constellation_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._Constellation_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "regionID":
-			out.Values[i] = ec._Constellation_regionID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "factionID":
-			out.Values[i] = ec._Constellation_factionID(ctx, field, obj)
-		case "region":
+		case "leaderboard":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8112,55 +14634,13 @@ func (ec *executionContext) _Constellation(ctx context.Context, sel ast.Selectio
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Constellation_region(ctx, field, obj)
+				res = ec._Query_leaderboard(ctx, field)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
-
-var corporationImplementors = []string{"Corporation"}
-
-func (ec *executionContext) _Corporation(ctx context.Context, sel ast.SelectionSet, obj *neo.Corporation) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, corporationImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Corporation")
-		case "id":
-			out.Values[i] = ec._Corporation_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._Corporation_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "ticker":
-			out.Values[i] = ec._Corporation_ticker(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "memberCount":
-			out.Values[i] = ec._Corporation_memberCount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "alliance":
+		case "topShips":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8168,9 +14648,139 @@ func (ec *executionContext) _Corporation(ctx context.Context, sel ast.SelectionS
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Corporation_alliance(ctx, field, obj)
+				res = ec._Query_topShips(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "activity":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_activity(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "typeByTypeID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_typeByTypeID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "groupByGroupID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_groupByGroupID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "categoryByGroupID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_categoryByGroupID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
+		case "solarSystemBySolarSystemID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_solarSystemBySolarSystemID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "constellationByConstellationID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_constellationByConstellationID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "regionByRegionID":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_regionByRegionID(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "me":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_me(ctx, field)
+				return res
+			})
+		case "watchlistKillmails":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_watchlistKillmails(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "__type":
+			out.Values[i] = ec._Query___type(ctx, field)
+		case "__schema":
+			out.Values[i] = ec._Query___schema(ctx, field)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8182,91 +14792,62 @@ func (ec *executionContext) _Corporation(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var killmailImplementors = []string{"Killmail"}
+var regionImplementors = []string{"Region"}
 
-func (ec *executionContext) _Killmail(ctx context.Context, sel ast.SelectionSet, obj *neo.Killmail) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, killmailImplementors)
+func (ec *executionContext) _Region(ctx context.Context, sel ast.SelectionSet, obj *neo.Region) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, regionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Killmail")
+			out.Values[i] = graphql.MarshalString("Region")
 		case "id":
-			out.Values[i] = ec._Killmail_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "hash":
-			out.Values[i] = ec._Killmail_hash(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "moonID":
-			out.Values[i] = ec._Killmail_moonID(ctx, field, obj)
-		case "solarSystemID":
-			out.Values[i] = ec._Killmail_solarSystemID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "warID":
-			out.Values[i] = ec._Killmail_warID(ctx, field, obj)
-		case "isNPC":
-			out.Values[i] = ec._Killmail_isNPC(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "isAwox":
-			out.Values[i] = ec._Killmail_isAwox(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "isSolo":
-			out.Values[i] = ec._Killmail_isSolo(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "droppedValue":
-			out.Values[i] = ec._Killmail_droppedValue(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "destroyedValue":
-			out.Values[i] = ec._Killmail_destroyedValue(ctx, field, obj)
+			out.Values[i] = ec._Region_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "fittedValue":
-			out.Values[i] = ec._Killmail_fittedValue(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._Region_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var relatedKillmailsImplementors = []string{"RelatedKillmails"}
+
+func (ec *executionContext) _RelatedKillmails(ctx context.Context, sel ast.SelectionSet, obj *neo.RelatedKillmails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relatedKillmailsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RelatedKillmails")
+		case "allianceID":
+			out.Values[i] = ec._RelatedKillmails_allianceID(ctx, field, obj)
 		case "totalValue":
-			out.Values[i] = ec._Killmail_totalValue(ctx, field, obj)
+			out.Values[i] = ec._RelatedKillmails_totalValue(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "killmailTime":
-			out.Values[i] = ec._Killmail_killmailTime(ctx, field, obj)
+		case "killmails":
+			out.Values[i] = ec._RelatedKillmails_killmails(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "system":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Killmail_system(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "attackers":
+		case "alliance":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8274,17 +14855,9 @@ func (ec *executionContext) _Killmail(ctx context.Context, sel ast.SelectionSet,
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Killmail_attackers(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._RelatedKillmails_alliance(ctx, field, obj)
 				return res
 			})
-		case "victim":
-			out.Values[i] = ec._Killmail_victim(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8296,83 +14869,38 @@ func (ec *executionContext) _Killmail(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var killmailAttackerImplementors = []string{"KillmailAttacker"}
+var shipCountImplementors = []string{"ShipCount"}
 
-func (ec *executionContext) _KillmailAttacker(ctx context.Context, sel ast.SelectionSet, obj *neo.KillmailAttacker) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, killmailAttackerImplementors)
+func (ec *executionContext) _ShipCount(ctx context.Context, sel ast.SelectionSet, obj *neo.ShipCount) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shipCountImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("KillmailAttacker")
-		case "killmailID":
-			out.Values[i] = ec._KillmailAttacker_killmailID(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ShipCount")
+		case "id":
+			out.Values[i] = ec._ShipCount_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "allianceID":
-			out.Values[i] = ec._KillmailAttacker_allianceID(ctx, field, obj)
-		case "characterID":
-			out.Values[i] = ec._KillmailAttacker_characterID(ctx, field, obj)
-		case "corporationID":
-			out.Values[i] = ec._KillmailAttacker_corporationID(ctx, field, obj)
-		case "factionID":
-			out.Values[i] = ec._KillmailAttacker_factionID(ctx, field, obj)
-		case "damageDone":
-			out.Values[i] = ec._KillmailAttacker_damageDone(ctx, field, obj)
+		case "grouping":
+			out.Values[i] = ec._ShipCount_grouping(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "finalBlow":
-			out.Values[i] = ec._KillmailAttacker_finalBlow(ctx, field, obj)
+		case "killmails":
+			out.Values[i] = ec._ShipCount_killmails(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "securityStatus":
-			out.Values[i] = ec._KillmailAttacker_securityStatus(ctx, field, obj)
+		case "totalValue":
+			out.Values[i] = ec._ShipCount_totalValue(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "shipTypeID":
-			out.Values[i] = ec._KillmailAttacker_shipTypeID(ctx, field, obj)
-		case "weaponTypeID":
-			out.Values[i] = ec._KillmailAttacker_weaponTypeID(ctx, field, obj)
-		case "alliance":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._KillmailAttacker_alliance(ctx, field, obj)
-				return res
-			})
-		case "corporation":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._KillmailAttacker_corporation(ctx, field, obj)
-				return res
-			})
-		case "character":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._KillmailAttacker_character(ctx, field, obj)
-				return res
-			})
-		case "ship":
+		case "type":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8380,10 +14908,10 @@ func (ec *executionContext) _KillmailAttacker(ctx context.Context, sel ast.Selec
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._KillmailAttacker_ship(ctx, field, obj)
+				res = ec._ShipCount_type(ctx, field, obj)
 				return res
 			})
-		case "weapon":
+		case "group":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8391,7 +14919,7 @@ func (ec *executionContext) _KillmailAttacker(ctx context.Context, sel ast.Selec
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._KillmailAttacker_weapon(ctx, field, obj)
+				res = ec._ShipCount_group(ctx, field, obj)
 				return res
 			})
 		default:
@@ -8405,68 +14933,47 @@ func (ec *executionContext) _KillmailAttacker(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var killmailItemImplementors = []string{"KillmailItem"}
+var solarSystemImplementors = []string{"SolarSystem"}
 
-func (ec *executionContext) _KillmailItem(ctx context.Context, sel ast.SelectionSet, obj *neo.KillmailItem) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, killmailItemImplementors)
+func (ec *executionContext) _SolarSystem(ctx context.Context, sel ast.SelectionSet, obj *neo.SolarSystem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, solarSystemImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("KillmailItem")
-		case "killmailID":
-			out.Values[i] = ec._KillmailItem_killmailID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "flag":
-			out.Values[i] = ec._KillmailItem_flag(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "itemTypeID":
-			out.Values[i] = ec._KillmailItem_itemTypeID(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("SolarSystem")
+		case "id":
+			out.Values[i] = ec._SolarSystem_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "quantityDropped":
-			out.Values[i] = ec._KillmailItem_quantityDropped(ctx, field, obj)
-		case "quantityDestroyed":
-			out.Values[i] = ec._KillmailItem_quantityDestroyed(ctx, field, obj)
-		case "singleton":
-			out.Values[i] = ec._KillmailItem_singleton(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._SolarSystem_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "isParent":
-			out.Values[i] = ec._KillmailItem_isParent(ctx, field, obj)
+		case "regionID":
+			out.Values[i] = ec._SolarSystem_regionID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "itemValue":
-			out.Values[i] = ec._KillmailItem_itemValue(ctx, field, obj)
+		case "constellationID":
+			out.Values[i] = ec._SolarSystem_constellationID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "totalValue":
-			out.Values[i] = ec._KillmailItem_totalValue(ctx, field, obj)
+		case "factionID":
+			out.Values[i] = ec._SolarSystem_factionID(ctx, field, obj)
+		case "sunTypeID":
+			out.Values[i] = ec._SolarSystem_sunTypeID(ctx, field, obj)
+		case "security":
+			out.Values[i] = ec._SolarSystem_security(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "type":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._KillmailItem_type(ctx, field, obj)
-				return res
-			})
-		case "typeflag":
+		case "constellation":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8474,114 +14981,82 @@ func (ec *executionContext) _KillmailItem(ctx context.Context, sel ast.Selection
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._KillmailItem_typeflag(ctx, field, obj)
+				res = ec._SolarSystem_constellation(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
 				return res
 			})
-		case "items":
-			out.Values[i] = ec._KillmailItem_items(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "killmailFeed":
+		return ec._Subscription_killmailFeed(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
-	return out
 }
 
-var killmailVictimImplementors = []string{"KillmailVictim"}
+var typeImplementors = []string{"Type"}
 
-func (ec *executionContext) _KillmailVictim(ctx context.Context, sel ast.SelectionSet, obj *neo.KillmailVictim) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, killmailVictimImplementors)
+func (ec *executionContext) _Type(ctx context.Context, sel ast.SelectionSet, obj *neo.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, typeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("KillmailVictim")
-		case "killmailID":
-			out.Values[i] = ec._KillmailVictim_killmailID(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Type")
+		case "id":
+			out.Values[i] = ec._Type_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "allianceID":
-			out.Values[i] = ec._KillmailVictim_allianceID(ctx, field, obj)
-		case "characterID":
-			out.Values[i] = ec._KillmailVictim_characterID(ctx, field, obj)
-		case "corporationID":
-			out.Values[i] = ec._KillmailVictim_corporationID(ctx, field, obj)
+		case "groupID":
+			out.Values[i] = ec._Type_groupID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "factionID":
-			out.Values[i] = ec._KillmailVictim_factionID(ctx, field, obj)
-		case "damageTaken":
-			out.Values[i] = ec._KillmailVictim_damageTaken(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._Type_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "shipTypeID":
-			out.Values[i] = ec._KillmailVictim_shipTypeID(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._Type_description(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "shipValue":
-			out.Values[i] = ec._KillmailVictim_shipValue(ctx, field, obj)
+		case "published":
+			out.Values[i] = ec._Type_published(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
-		case "alliance":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._KillmailVictim_alliance(ctx, field, obj)
-				return res
-			})
-		case "corporation":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._KillmailVictim_corporation(ctx, field, obj)
-				return res
-			})
-		case "character":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._KillmailVictim_character(ctx, field, obj)
-				return res
-			})
-		case "ship":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._KillmailVictim_ship(ctx, field, obj)
-				return res
-			})
-		case "position":
-			out.Values[i] = ec._KillmailVictim_position(ctx, field, obj)
-		case "items":
+		case "marketGroupID":
+			out.Values[i] = ec._Type_marketGroupID(ctx, field, obj)
+		case "group":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8589,13 +15064,13 @@ func (ec *executionContext) _KillmailVictim(ctx context.Context, sel ast.Selecti
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._KillmailVictim_items(ctx, field, obj)
+				res = ec._Type_group(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "fitted":
+		case "attributes":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8603,7 +15078,7 @@ func (ec *executionContext) _KillmailVictim(ctx context.Context, sel ast.Selecti
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._KillmailVictim_fitted(ctx, field, obj)
+				res = ec._Type_attributes(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
@@ -8620,23 +15095,29 @@ func (ec *executionContext) _KillmailVictim(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
-
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+var typeAttributeImplementors = []string{"TypeAttribute"}
 
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) _TypeAttribute(ctx context.Context, sel ast.SelectionSet, obj *neo.TypeAttribute) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, typeAttributeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "mutationPlaceholder":
-			out.Values[i] = ec._Mutation_mutationPlaceholder(ctx, field)
+			out.Values[i] = graphql.MarshalString("TypeAttribute")
+		case "typeID":
+			out.Values[i] = ec._TypeAttribute_typeID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "attributeID":
+			out.Values[i] = ec._TypeAttribute_attributeID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "value":
+			out.Values[i] = ec._TypeAttribute_value(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8651,23 +15132,32 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 	return out
 }
 
-var positionImplementors = []string{"Position"}
+var typeCategoryImplementors = []string{"TypeCategory"}
 
-func (ec *executionContext) _Position(ctx context.Context, sel ast.SelectionSet, obj *neo.Position) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, positionImplementors)
+func (ec *executionContext) _TypeCategory(ctx context.Context, sel ast.SelectionSet, obj *neo.TypeCategory) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, typeCategoryImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Position")
-		case "x":
-			out.Values[i] = ec._Position_x(ctx, field, obj)
-		case "y":
-			out.Values[i] = ec._Position_y(ctx, field, obj)
-		case "z":
-			out.Values[i] = ec._Position_z(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("TypeCategory")
+		case "id":
+			out.Values[i] = ec._TypeCategory_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._TypeCategory_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "published":
+			out.Values[i] = ec._TypeCategory_published(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8679,64 +15169,75 @@ func (ec *executionContext) _Position(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var typeFlagImplementors = []string{"TypeFlag"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+func (ec *executionContext) _TypeFlag(ctx context.Context, sel ast.SelectionSet, obj *neo.TypeFlag) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, typeFlagImplementors)
 
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TypeFlag")
+		case "id":
+			out.Values[i] = ec._TypeFlag_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+			out.Values[i] = ec._TypeFlag_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "text":
+			out.Values[i] = ec._TypeFlag_text(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var typeGroupImplementors = []string{"TypeGroup"}
+
+func (ec *executionContext) _TypeGroup(ctx context.Context, sel ast.SelectionSet, obj *neo.TypeGroup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, typeGroupImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "queryPlaceholder":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_queryPlaceholder(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "allianceByAllianceID":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_allianceByAllianceID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "characterByCharacterID":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_characterByCharacterID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "corporationByCorporationID":
+			out.Values[i] = graphql.MarshalString("TypeGroup")
+		case "id":
+			out.Values[i] = ec._TypeGroup_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "categoryID":
+			out.Values[i] = ec._TypeGroup_categoryID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._TypeGroup_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "published":
+			out.Values[i] = ec._TypeGroup_published(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "category":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8744,13 +15245,40 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_corporationByCorporationID(ctx, field)
+				res = ec._TypeGroup_category(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "killmail":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var viewerImplementors = []string{"Viewer"}
+
+func (ec *executionContext) _Viewer(ctx context.Context, sel ast.SelectionSet, obj *neo.Viewer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, viewerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Viewer")
+		case "characterID":
+			out.Values[i] = ec._Viewer_characterID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "character":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8758,13 +15286,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_killmail(ctx, field)
+				res = ec._Viewer_character(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "killmailRecent":
+		case "corporation":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8772,13 +15300,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_killmailRecent(ctx, field)
+				res = ec._Viewer_corporation(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "mvByEntityID":
+		case "alliance":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8786,13 +15314,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_mvByEntityID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._Viewer_alliance(ctx, field, obj)
 				return res
 			})
-		case "killmailsByEntityID":
+		case "characters":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8800,13 +15325,13 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_killmailsByEntityID(ctx, field)
+				res = ec._Viewer_characters(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "typeByTypeID":
+		case "watchlist":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8814,13 +15339,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_typeByTypeID(ctx, field)
+				res = ec._Viewer_watchlist(ctx, field, obj)
 				if res == graphql.Null {
 					atomic.AddUint32(&invalids, 1)
 				}
 				return res
 			})
-		case "groupByGroupID":
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var watchlistEntryImplementors = []string{"WatchlistEntry"}
+
+func (ec *executionContext) _WatchlistEntry(ctx context.Context, sel ast.SelectionSet, obj *neo.WatchlistEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, watchlistEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WatchlistEntry")
+		case "entity":
+			out.Values[i] = ec._WatchlistEntry_entity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "entityID":
+			out.Values[i] = ec._WatchlistEntry_entityID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._WatchlistEntry_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "character":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8828,13 +15390,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_groupByGroupID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._WatchlistEntry_character(ctx, field, obj)
 				return res
 			})
-		case "categoryByGroupID":
+		case "corporation":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8842,13 +15401,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_categoryByGroupID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._WatchlistEntry_corporation(ctx, field, obj)
 				return res
 			})
-		case "solarSystemBySolarSystemID":
+		case "alliance":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8856,13 +15412,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_solarSystemBySolarSystemID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._WatchlistEntry_alliance(ctx, field, obj)
 				return res
 			})
-		case "constellationByConstellationID":
+		case "system":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8870,13 +15423,10 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_constellationByConstellationID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._WatchlistEntry_system(ctx, field, obj)
 				return res
 			})
-		case "regionByRegionID":
+		case "ship":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
 				defer func() {
@@ -8884,16 +15434,9 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Query_regionByRegionID(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
+				res = ec._WatchlistEntry_ship(ctx, field, obj)
 				return res
 			})
-		case "__type":
-			out.Values[i] = ec._Query___type(ctx, field)
-		case "__schema":
-			out.Values[i] = ec._Query___schema(ctx, field)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -8905,24 +15448,31 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var regionImplementors = []string{"Region"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _Region(ctx context.Context, sel ast.SelectionSet, obj *neo.Region) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, regionImplementors)
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Region")
-		case "id":
-			out.Values[i] = ec._Region_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "name":
-			out.Values[i] = ec._Region_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -8937,60 +15487,77 @@ func (ec *executionContext) _Region(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
-var solarSystemImplementors = []string{"SolarSystem"}
+var __EnumValueImplementors = []string{"__EnumValue"}
 
-func (ec *executionContext) _SolarSystem(ctx context.Context, sel ast.SelectionSet, obj *neo.SolarSystem) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, solarSystemImplementors)
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SolarSystem")
-		case "id":
-			out.Values[i] = ec._SolarSystem_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
 		case "name":
-			out.Values[i] = ec._SolarSystem_name(ctx, field, obj)
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "regionID":
-			out.Values[i] = ec._SolarSystem_regionID(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "constellationID":
-			out.Values[i] = ec._SolarSystem_constellationID(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "factionID":
-			out.Values[i] = ec._SolarSystem_factionID(ctx, field, obj)
-		case "sunTypeID":
-			out.Values[i] = ec._SolarSystem_sunTypeID(ctx, field, obj)
-		case "security":
-			out.Values[i] = ec._SolarSystem_security(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "constellation":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._SolarSystem_constellation(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9002,92 +15569,72 @@ func (ec *executionContext) _SolarSystem(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var subscriptionImplementors = []string{"Subscription"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func() graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Subscription",
-	})
-	if len(fields) != 1 {
-		ec.Errorf(ctx, "must subscribe to exactly one stream")
-		return nil
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
 	}
-
-	switch fields[0].Name {
-	case "killmailFeed":
-		return ec._Subscription_killmailFeed(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
 	}
+	return out
 }
 
-var typeImplementors = []string{"Type"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _Type(ctx context.Context, sel ast.SelectionSet, obj *neo.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, typeImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Type")
-		case "id":
-			out.Values[i] = ec._Type_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "groupID":
-			out.Values[i] = ec._Type_groupID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._Type_name(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "description":
-			out.Values[i] = ec._Type_description(ctx, field, obj)
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "published":
-			out.Values[i] = ec._Type_published(ctx, field, obj)
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
+				invalids++
 			}
-		case "marketGroupID":
-			out.Values[i] = ec._Type_marketGroupID(ctx, field, obj)
-		case "group":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Type_group(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		case "attributes":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Type_attributes(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -9099,439 +15646,458 @@ func (ec *executionContext) _Type(ctx context.Context, sel ast.SelectionSet, obj
 	return out
 }
 
-var typeAttributeImplementors = []string{"TypeAttribute"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _TypeAttribute(ctx context.Context, sel ast.SelectionSet, obj *neo.TypeAttribute) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, typeAttributeImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TypeAttribute")
-		case "typeID":
-			out.Values[i] = ec._TypeAttribute_typeID(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "attributeID":
-			out.Values[i] = ec._TypeAttribute_attributeID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) marshalNActivity2githubᚗcomᚋeveisesiᚋneoᚐActivity(ctx context.Context, sel ast.SelectionSet, v neo.Activity) graphql.Marshaler {
+	return ec._Activity(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNActivity2ᚖgithubᚗcomᚋeveisesiᚋneoᚐActivity(ctx context.Context, sel ast.SelectionSet, v *neo.Activity) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Activity(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNAlliance2githubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx context.Context, sel ast.SelectionSet, v neo.Alliance) graphql.Marshaler {
+	return ec._Alliance(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAlliance2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx context.Context, sel ast.SelectionSet, v []*neo.Alliance) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "value":
-			out.Values[i] = ec._TypeAttribute_value(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+			ret[i] = ec.marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx context.Context, sel ast.SelectionSet, v *neo.Alliance) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Alliance(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNBattleReport2githubᚗcomᚋeveisesiᚋneoᚐBattleReport(ctx context.Context, sel ast.SelectionSet, v neo.BattleReport) graphql.Marshaler {
+	return ec._BattleReport(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNBattleReport2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleReport(ctx context.Context, sel ast.SelectionSet, v []*neo.BattleReport) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOBattleReport2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleReport(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNBattleReport2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleReport(ctx context.Context, sel ast.SelectionSet, v *neo.BattleReport) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._BattleReport(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNBattleReportFilter2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐBattleReportFilter(ctx context.Context, v interface{}) (models.BattleReportFilter, error) {
+	res, err := ec.unmarshalInputBattleReportFilter(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNBattleScope2githubᚗcomᚋeveisesiᚋneoᚐBattleScope(ctx context.Context, v interface{}) (neo.BattleScope, error) {
+	var res neo.BattleScope
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBattleScope2githubᚗcomᚋeveisesiᚋneoᚐBattleScope(ctx context.Context, sel ast.SelectionSet, v neo.BattleScope) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNBattleSide2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleSide(ctx context.Context, sel ast.SelectionSet, v []*neo.BattleSide) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalOBattleSide2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleSide(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var typeCategoryImplementors = []string{"TypeCategory"}
-
-func (ec *executionContext) _TypeCategory(ctx context.Context, sel ast.SelectionSet, obj *neo.TypeCategory) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, typeCategoryImplementors)
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TypeCategory")
-		case "id":
-			out.Values[i] = ec._TypeCategory_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._TypeCategory_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "published":
-			out.Values[i] = ec._TypeCategory_published(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var typeFlagImplementors = []string{"TypeFlag"}
-
-func (ec *executionContext) _TypeFlag(ctx context.Context, sel ast.SelectionSet, obj *neo.TypeFlag) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, typeFlagImplementors)
+func (ec *executionContext) marshalNCharacter2githubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx context.Context, sel ast.SelectionSet, v neo.Character) graphql.Marshaler {
+	return ec._Character(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TypeFlag")
-		case "id":
-			out.Values[i] = ec._TypeFlag_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec._TypeFlag_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "text":
-			out.Values[i] = ec._TypeFlag_text(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNCharacter2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx context.Context, sel ast.SelectionSet, v []*neo.Character) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalOCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx context.Context, sel ast.SelectionSet, v *neo.Character) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._Character(ctx, sel, v)
 }
 
-var typeGroupImplementors = []string{"TypeGroup"}
-
-func (ec *executionContext) _TypeGroup(ctx context.Context, sel ast.SelectionSet, obj *neo.TypeGroup) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, typeGroupImplementors)
+func (ec *executionContext) marshalNConstellation2githubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx context.Context, sel ast.SelectionSet, v neo.Constellation) graphql.Marshaler {
+	return ec._Constellation(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("TypeGroup")
-		case "id":
-			out.Values[i] = ec._TypeGroup_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "categoryID":
-			out.Values[i] = ec._TypeGroup_categoryID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "name":
-			out.Values[i] = ec._TypeGroup_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "published":
-			out.Values[i] = ec._TypeGroup_published(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&invalids, 1)
-			}
-		case "category":
-			field := field
-			out.Concurrently(i, func() (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._TypeGroup_category(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&invalids, 1)
-				}
-				return res
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNConstellation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx context.Context, sel ast.SelectionSet, v *neo.Constellation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._Constellation(ctx, sel, v)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
-
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNCorporation2githubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx context.Context, sel ast.SelectionSet, v neo.Corporation) graphql.Marshaler {
+	return ec._Corporation(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
+func (ec *executionContext) marshalNCorporation2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx context.Context, sel ast.SelectionSet, v []*neo.Corporation) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalOCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	wg.Wait()
+	return ret
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
-
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx context.Context, sel ast.SelectionSet, v *neo.Corporation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._Corporation(ctx, sel, v)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) unmarshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx context.Context, v interface{}) (models.Entity, error) {
+	var res models.Entity
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx context.Context, sel ast.SelectionSet, v models.Entity) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNEntityStats2githubᚗcomᚋeveisesiᚋneoᚐEntityStats(ctx context.Context, sel ast.SelectionSet, v neo.EntityStats) graphql.Marshaler {
+	return ec._EntityStats(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEntityStats2ᚖgithubᚗcomᚋeveisesiᚋneoᚐEntityStats(ctx context.Context, sel ast.SelectionSet, v *neo.EntityStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
 		return graphql.Null
 	}
-	return out
+	return ec._EntityStats(ctx, sel, v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
-
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloat(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var __SchemaImplementors = []string{"__Schema"}
-
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-var __TypeImplementors = []string{"__Type"}
-
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) unmarshalNInt2int64(ctx context.Context, v interface{}) (int64, error) {
+	res, err := scalar.UnmarshalInt64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	res := scalar.MarshalInt64(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
+	return res
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) unmarshalNInt2uint(ctx context.Context, v interface{}) (uint, error) {
+	res, err := scalar.UnmarshalUint(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNInt2uint(ctx context.Context, sel ast.SelectionSet, v uint) graphql.Marshaler {
+	res := scalar.MarshalUint(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
 
-func (ec *executionContext) marshalNAlliance2githubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx context.Context, sel ast.SelectionSet, v neo.Alliance) graphql.Marshaler {
-	return ec._Alliance(ctx, sel, &v)
+func (ec *executionContext) unmarshalNInt2uint64(ctx context.Context, v interface{}) (uint64, error) {
+	res, err := scalar.UnmarshalUint64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx context.Context, sel ast.SelectionSet, v *neo.Alliance) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) marshalNInt2uint64(ctx context.Context, sel ast.SelectionSet, v uint64) graphql.Marshaler {
+	res := scalar.MarshalUint64(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return graphql.Null
 	}
-	return ec._Alliance(ctx, sel, v)
+	return res
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNInt2uint8(ctx context.Context, v interface{}) (uint8, error) {
+	res, err := scalar.UnmarshalUint8(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
+func (ec *executionContext) marshalNInt2uint8(ctx context.Context, sel ast.SelectionSet, v uint8) graphql.Marshaler {
+	res := scalar.MarshalUint8(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
@@ -9540,174 +16106,366 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
-func (ec *executionContext) marshalNCharacter2githubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx context.Context, sel ast.SelectionSet, v neo.Character) graphql.Marshaler {
-	return ec._Character(ctx, sel, &v)
+func (ec *executionContext) unmarshalNInt2ᚕuintᚄ(ctx context.Context, v interface{}) ([]uint, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]uint, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNInt2uint(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalNCharacter2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCharacter(ctx context.Context, sel ast.SelectionSet, v *neo.Character) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) marshalNInt2ᚕuintᚄ(ctx context.Context, sel ast.SelectionSet, v []uint) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNInt2uint(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalNInt2ᚕᚕuintᚄ(ctx context.Context, v interface{}) ([][]uint, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
-		return graphql.Null
 	}
-	return ec._Character(ctx, sel, v)
+	var err error
+	res := make([][]uint, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNInt2ᚕuintᚄ(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalNConstellation2githubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx context.Context, sel ast.SelectionSet, v neo.Constellation) graphql.Marshaler {
-	return ec._Constellation(ctx, sel, &v)
+func (ec *executionContext) marshalNInt2ᚕᚕuintᚄ(ctx context.Context, sel ast.SelectionSet, v [][]uint) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNInt2ᚕuintᚄ(ctx, sel, v[i])
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNConstellation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx context.Context, sel ast.SelectionSet, v *neo.Constellation) graphql.Marshaler {
+func (ec *executionContext) unmarshalNInt2ᚖuint(ctx context.Context, v interface{}) (*uint, error) {
+	res, err := scalar.UnmarshalUint(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2ᚖuint(ctx context.Context, sel ast.SelectionSet, v *uint) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Constellation(ctx, sel, v)
+	res := scalar.MarshalUint(*v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
 }
 
-func (ec *executionContext) marshalNCorporation2githubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx context.Context, sel ast.SelectionSet, v neo.Corporation) graphql.Marshaler {
-	return ec._Corporation(ctx, sel, &v)
+func (ec *executionContext) marshalNKillmail2githubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx context.Context, sel ast.SelectionSet, v neo.Killmail) graphql.Marshaler {
+	return ec._Killmail(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx context.Context, sel ast.SelectionSet, v *neo.Corporation) graphql.Marshaler {
+func (ec *executionContext) marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx context.Context, sel ast.SelectionSet, v []*neo.Killmail) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx context.Context, sel ast.SelectionSet, v *neo.Killmail) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Corporation(ctx, sel, v)
+	return ec._Killmail(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx context.Context, v interface{}) (models.Entity, error) {
-	var res models.Entity
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+func (ec *executionContext) marshalNKillmailAttacker2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailAttacker(ctx context.Context, sel ast.SelectionSet, v []*neo.KillmailAttacker) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOKillmailAttacker2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailAttacker(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNEntity2githubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐEntity(ctx context.Context, sel ast.SelectionSet, v models.Entity) graphql.Marshaler {
-	return v
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
-	res, err := graphql.UnmarshalFloat(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNKillmailConnection2githubᚗcomᚋeveisesiᚋneoᚐKillmailConnection(ctx context.Context, sel ast.SelectionSet, v neo.KillmailConnection) graphql.Marshaler {
+	return ec._KillmailConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	res := graphql.MarshalFloat(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNKillmailConnection2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailConnection(ctx context.Context, sel ast.SelectionSet, v *neo.KillmailConnection) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._KillmailConnection(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNKillmailEdge2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*neo.KillmailEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNKillmailEdge2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNKillmailEdge2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailEdge(ctx context.Context, sel ast.SelectionSet, v *neo.KillmailEdge) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._KillmailEdge(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2int64(ctx context.Context, v interface{}) (int64, error) {
-	res, err := scalar.UnmarshalInt64(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNInt2int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
-	res := scalar.MarshalInt64(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx context.Context, sel ast.SelectionSet, v []*neo.KillmailItem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOKillmailItem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	return res
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) unmarshalNInt2uint(ctx context.Context, v interface{}) (uint, error) {
-	res, err := scalar.UnmarshalUint(v)
+func (ec *executionContext) unmarshalNKillmailTag2githubᚗcomᚋeveisesiᚋneoᚐKillmailTag(ctx context.Context, v interface{}) (neo.KillmailTag, error) {
+	var res neo.KillmailTag
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNInt2uint(ctx context.Context, sel ast.SelectionSet, v uint) graphql.Marshaler {
-	res := scalar.MarshalUint(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalNInt2uint64(ctx context.Context, v interface{}) (uint64, error) {
-	res, err := scalar.UnmarshalUint64(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNKillmailTag2githubᚗcomᚋeveisesiᚋneoᚐKillmailTag(ctx context.Context, sel ast.SelectionSet, v neo.KillmailTag) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNInt2uint64(ctx context.Context, sel ast.SelectionSet, v uint64) graphql.Marshaler {
-	res := scalar.MarshalUint64(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
+func (ec *executionContext) unmarshalNKillmailTag2ᚕgithubᚗcomᚋeveisesiᚋneoᚐKillmailTagᚄ(ctx context.Context, v interface{}) ([]neo.KillmailTag, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
 		}
 	}
-	return res
+	var err error
+	res := make([]neo.KillmailTag, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNKillmailTag2githubᚗcomᚋeveisesiᚋneoᚐKillmailTag(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) unmarshalNInt2uint8(ctx context.Context, v interface{}) (uint8, error) {
-	res, err := scalar.UnmarshalUint8(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNKillmailTag2ᚕgithubᚗcomᚋeveisesiᚋneoᚐKillmailTagᚄ(ctx context.Context, sel ast.SelectionSet, v []neo.KillmailTag) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNKillmailTag2githubᚗcomᚋeveisesiᚋneoᚐKillmailTag(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) marshalNInt2uint8(ctx context.Context, sel ast.SelectionSet, v uint8) graphql.Marshaler {
-	res := scalar.MarshalUint8(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNKillmailVictim2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailVictim(ctx context.Context, sel ast.SelectionSet, v *neo.KillmailVictim) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._KillmailVictim(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNInt2ᚖuint(ctx context.Context, v interface{}) (*uint, error) {
-	res, err := scalar.UnmarshalUint(v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNLeaderboard2githubᚗcomᚋeveisesiᚋneoᚐLeaderboard(ctx context.Context, sel ast.SelectionSet, v neo.Leaderboard) graphql.Marshaler {
+	return ec._Leaderboard(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNInt2ᚖuint(ctx context.Context, sel ast.SelectionSet, v *uint) graphql.Marshaler {
+func (ec *executionContext) marshalNLeaderboard2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboard(ctx context.Context, sel ast.SelectionSet, v *neo.Leaderboard) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	res := scalar.MarshalUint(*v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-	}
-	return res
+	return ec._Leaderboard(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNKillmail2githubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx context.Context, sel ast.SelectionSet, v neo.Killmail) graphql.Marshaler {
-	return ec._Killmail(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx context.Context, sel ast.SelectionSet, v []*neo.Killmail) graphql.Marshaler {
+func (ec *executionContext) marshalNLeaderboardEntry2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*neo.LeaderboardEntry) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9731,7 +16489,7 @@ func (ec *executionContext) marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneo
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx, sel, v[i])
+			ret[i] = ec.marshalNLeaderboardEntry2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardEntry(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9744,17 +16502,61 @@ func (ec *executionContext) marshalNKillmail2ᚕᚖgithubᚗcomᚋeveisesiᚋneo
 	return ret
 }
 
-func (ec *executionContext) marshalNKillmail2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmail(ctx context.Context, sel ast.SelectionSet, v *neo.Killmail) graphql.Marshaler {
+func (ec *executionContext) marshalNLeaderboardEntry2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardEntry(ctx context.Context, sel ast.SelectionSet, v *neo.LeaderboardEntry) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "must not be null")
 		}
 		return graphql.Null
 	}
-	return ec._Killmail(ctx, sel, v)
+	return ec._LeaderboardEntry(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNKillmailAttacker2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailAttacker(ctx context.Context, sel ast.SelectionSet, v []*neo.KillmailAttacker) graphql.Marshaler {
+func (ec *executionContext) unmarshalNLeaderboardMetric2githubᚗcomᚋeveisesiᚋneoᚐLeaderboardMetric(ctx context.Context, v interface{}) (neo.LeaderboardMetric, error) {
+	var res neo.LeaderboardMetric
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLeaderboardMetric2githubᚗcomᚋeveisesiᚋneoᚐLeaderboardMetric(ctx context.Context, sel ast.SelectionSet, v neo.LeaderboardMetric) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNLeaderboardPeriod2githubᚗcomᚋeveisesiᚋneoᚐLeaderboardPeriod(ctx context.Context, v interface{}) (neo.LeaderboardPeriod, error) {
+	var res neo.LeaderboardPeriod
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNLeaderboardPeriod2githubᚗcomᚋeveisesiᚋneoᚐLeaderboardPeriod(ctx context.Context, sel ast.SelectionSet, v neo.LeaderboardPeriod) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋeveisesiᚋneoᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *neo.PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRegion2githubᚗcomᚋeveisesiᚋneoᚐRegion(ctx context.Context, sel ast.SelectionSet, v neo.Region) graphql.Marshaler {
+	return ec._Region(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRegion2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRegion(ctx context.Context, sel ast.SelectionSet, v *neo.Region) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._Region(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRelatedKillmails2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐRelatedKillmails(ctx context.Context, sel ast.SelectionSet, v []*neo.RelatedKillmails) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9778,7 +16580,7 @@ func (ec *executionContext) marshalNKillmailAttacker2ᚕᚖgithubᚗcomᚋeveise
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOKillmailAttacker2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailAttacker(ctx, sel, v[i])
+			ret[i] = ec.marshalORelatedKillmails2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRelatedKillmails(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9791,7 +16593,7 @@ func (ec *executionContext) marshalNKillmailAttacker2ᚕᚖgithubᚗcomᚋeveise
 	return ret
 }
 
-func (ec *executionContext) marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx context.Context, sel ast.SelectionSet, v []*neo.KillmailItem) graphql.Marshaler {
+func (ec *executionContext) marshalNShipCount2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐShipCount(ctx context.Context, sel ast.SelectionSet, v []*neo.ShipCount) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -9815,7 +16617,7 @@ func (ec *executionContext) marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesi
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOKillmailItem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailItem(ctx, sel, v[i])
+			ret[i] = ec.marshalOShipCount2ᚖgithubᚗcomᚋeveisesiᚋneoᚐShipCount(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -9828,28 +16630,24 @@ func (ec *executionContext) marshalNKillmailItem2ᚕᚖgithubᚗcomᚋeveisesi
 	return ret
 }
 
-func (ec *executionContext) marshalNKillmailVictim2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailVictim(ctx context.Context, sel ast.SelectionSet, v *neo.KillmailVictim) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._KillmailVictim(ctx, sel, v)
+func (ec *executionContext) unmarshalNShipGrouping2githubᚗcomᚋeveisesiᚋneoᚐShipGrouping(ctx context.Context, v interface{}) (neo.ShipGrouping, error) {
+	var res neo.ShipGrouping
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNRegion2githubᚗcomᚋeveisesiᚋneoᚐRegion(ctx context.Context, sel ast.SelectionSet, v neo.Region) graphql.Marshaler {
-	return ec._Region(ctx, sel, &v)
+func (ec *executionContext) marshalNShipGrouping2githubᚗcomᚋeveisesiᚋneoᚐShipGrouping(ctx context.Context, sel ast.SelectionSet, v neo.ShipGrouping) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNRegion2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRegion(ctx context.Context, sel ast.SelectionSet, v *neo.Region) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
-	}
-	return ec._Region(ctx, sel, v)
+func (ec *executionContext) unmarshalNShipUsage2githubᚗcomᚋeveisesiᚋneoᚐShipUsage(ctx context.Context, v interface{}) (neo.ShipUsage, error) {
+	var res neo.ShipUsage
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNShipUsage2githubᚗcomᚋeveisesiᚋneoᚐShipUsage(ctx context.Context, sel ast.SelectionSet, v neo.ShipUsage) graphql.Marshaler {
+	return v
 }
 
 func (ec *executionContext) marshalNSolarSystem2githubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx context.Context, sel ast.SelectionSet, v neo.SolarSystem) graphql.Marshaler {
@@ -9866,6 +16664,16 @@ func (ec *executionContext) marshalNSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneo
 	return ec._SolarSystem(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNStatPeriod2githubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx context.Context, v interface{}) (neo.StatPeriod, error) {
+	var res neo.StatPeriod
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNStatPeriod2githubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx context.Context, sel ast.SelectionSet, v neo.StatPeriod) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -9975,6 +16783,67 @@ func (ec *executionContext) marshalNTypeGroup2ᚖgithubᚗcomᚋeveisesiᚋneo
 	return ec._TypeGroup(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNWatchEntity2githubᚗcomᚋeveisesiᚋneoᚐWatchEntity(ctx context.Context, v interface{}) (neo.WatchEntity, error) {
+	var res neo.WatchEntity
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWatchEntity2githubᚗcomᚋeveisesiᚋneoᚐWatchEntity(ctx context.Context, sel ast.SelectionSet, v neo.WatchEntity) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNWatchlistEntry2githubᚗcomᚋeveisesiᚋneoᚐWatchlistEntry(ctx context.Context, sel ast.SelectionSet, v neo.WatchlistEntry) graphql.Marshaler {
+	return ec._WatchlistEntry(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNWatchlistEntry2ᚕᚖgithubᚗcomᚋeveisesiᚋneoᚐWatchlistEntry(ctx context.Context, sel ast.SelectionSet, v []*neo.WatchlistEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOWatchlistEntry2ᚖgithubᚗcomᚋeveisesiᚋneoᚐWatchlistEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNWatchlistEntry2ᚖgithubᚗcomᚋeveisesiᚋneoᚐWatchlistEntry(ctx context.Context, sel ast.SelectionSet, v *neo.WatchlistEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._WatchlistEntry(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -10186,29 +17055,59 @@ func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgen
 		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx context.Context, sel ast.SelectionSet, v *neo.Alliance) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Alliance(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOBattleReport2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleReport(ctx context.Context, sel ast.SelectionSet, v *neo.BattleReport) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._BattleReport(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
+func (ec *executionContext) unmarshalOBattleScope2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleScope(ctx context.Context, v interface{}) (*neo.BattleScope, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(neo.BattleScope)
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "must not be null")
-		}
+func (ec *executionContext) marshalOBattleScope2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleScope(ctx context.Context, sel ast.SelectionSet, v *neo.BattleScope) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res
+	return v
 }
 
-func (ec *executionContext) marshalOAlliance2ᚖgithubᚗcomᚋeveisesiᚋneoᚐAlliance(ctx context.Context, sel ast.SelectionSet, v *neo.Alliance) graphql.Marshaler {
+func (ec *executionContext) marshalOBattleSide2ᚖgithubᚗcomᚋeveisesiᚋneoᚐBattleSide(ctx context.Context, sel ast.SelectionSet, v *neo.BattleSide) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._Alliance(ctx, sel, v)
+	return ec._BattleSide(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
@@ -10266,6 +17165,13 @@ func (ec *executionContext) marshalOCharacter2ᚖgithubᚗcomᚋeveisesiᚋneo
 	return ec._Character(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOConstellation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐConstellation(ctx context.Context, sel ast.SelectionSet, v *neo.Constellation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Constellation(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOCorporation2ᚖgithubᚗcomᚋeveisesiᚋneoᚐCorporation(ctx context.Context, sel ast.SelectionSet, v *neo.Corporation) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -10298,6 +17204,66 @@ func (ec *executionContext) marshalOFloat2float64(ctx context.Context, sel ast.S
 	return graphql.MarshalFloat(v)
 }
 
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalFloat(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return graphql.MarshalFloat(*v)
+}
+
+func (ec *executionContext) unmarshalOInt2uint64(ctx context.Context, v interface{}) (uint64, error) {
+	res, err := scalar.UnmarshalUint64(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2uint64(ctx context.Context, sel ast.SelectionSet, v uint64) graphql.Marshaler {
+	return scalar.MarshalUint64(v)
+}
+
+func (ec *executionContext) unmarshalOInt2ᚕintᚄ(ctx context.Context, v interface{}) ([]int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]int, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNInt2int(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOInt2ᚕintᚄ(ctx context.Context, sel ast.SelectionSet, v []int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNInt2int(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
 	if v == nil {
 		return nil, nil
@@ -10365,6 +17331,14 @@ func (ec *executionContext) marshalOKillmailAttacker2ᚖgithubᚗcomᚋeveisesi
 	return ec._KillmailAttacker(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOKillmailFeedFilter2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐKillmailFeedFilter(ctx context.Context, v interface{}) (*models.KillmailFeedFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputKillmailFeedFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOKillmailFilter2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐKillmailFilter(ctx context.Context, v interface{}) (*models.KillmailFilter, error) {
 	if v == nil {
 		return nil, nil
@@ -10380,6 +17354,118 @@ func (ec *executionContext) marshalOKillmailItem2ᚖgithubᚗcomᚋeveisesiᚋne
 	return ec._KillmailItem(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOKillmailSide2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailSide(ctx context.Context, v interface{}) (*neo.KillmailSide, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(neo.KillmailSide)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOKillmailSide2ᚖgithubᚗcomᚋeveisesiᚋneoᚐKillmailSide(ctx context.Context, sel ast.SelectionSet, v *neo.KillmailSide) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOKillmailTag2ᚕgithubᚗcomᚋeveisesiᚋneoᚐKillmailTagᚄ(ctx context.Context, v interface{}) ([]neo.KillmailTag, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]neo.KillmailTag, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNKillmailTag2githubᚗcomᚋeveisesiᚋneoᚐKillmailTag(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOKillmailTag2ᚕgithubᚗcomᚋeveisesiᚋneoᚐKillmailTagᚄ(ctx context.Context, sel ast.SelectionSet, v []neo.KillmailTag) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNKillmailTag2githubᚗcomᚋeveisesiᚋneoᚐKillmailTag(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOLeaderboardMetric2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardMetric(ctx context.Context, v interface{}) (*neo.LeaderboardMetric, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(neo.LeaderboardMetric)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOLeaderboardMetric2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardMetric(ctx context.Context, sel ast.SelectionSet, v *neo.LeaderboardMetric) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOLeaderboardPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardPeriod(ctx context.Context, v interface{}) (*neo.LeaderboardPeriod, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(neo.LeaderboardPeriod)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOLeaderboardPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐLeaderboardPeriod(ctx context.Context, sel ast.SelectionSet, v *neo.LeaderboardPeriod) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOPosition2ᚖgithubᚗcomᚋeveisesiᚋneoᚐPosition(ctx context.Context, sel ast.SelectionSet, v *neo.Position) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -10387,6 +17473,75 @@ func (ec *executionContext) marshalOPosition2ᚖgithubᚗcomᚋeveisesiᚋneoᚐ
 	return ec._Position(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalORelatedKillmails2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRelatedKillmails(ctx context.Context, sel ast.SelectionSet, v *neo.RelatedKillmails) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RelatedKillmails(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORelatedRadius2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRelatedRadius(ctx context.Context, v interface{}) (*neo.RelatedRadius, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(neo.RelatedRadius)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORelatedRadius2ᚖgithubᚗcomᚋeveisesiᚋneoᚐRelatedRadius(ctx context.Context, sel ast.SelectionSet, v *neo.RelatedRadius) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOShipCount2ᚖgithubᚗcomᚋeveisesiᚋneoᚐShipCount(ctx context.Context, sel ast.SelectionSet, v *neo.ShipCount) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ShipCount(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOShipGrouping2ᚖgithubᚗcomᚋeveisesiᚋneoᚐShipGrouping(ctx context.Context, v interface{}) (*neo.ShipGrouping, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(neo.ShipGrouping)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOShipGrouping2ᚖgithubᚗcomᚋeveisesiᚋneoᚐShipGrouping(ctx context.Context, sel ast.SelectionSet, v *neo.ShipGrouping) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOSolarSystem2ᚖgithubᚗcomᚋeveisesiᚋneoᚐSolarSystem(ctx context.Context, sel ast.SelectionSet, v *neo.SolarSystem) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SolarSystem(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOStatPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx context.Context, v interface{}) (*neo.StatPeriod, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(neo.StatPeriod)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOStatPeriod2ᚖgithubᚗcomᚋeveisesiᚋneoᚐStatPeriod(ctx context.Context, sel ast.SelectionSet, v *neo.StatPeriod) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -10411,6 +17566,14 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return graphql.MarshalString(*v)
 }
 
+func (ec *executionContext) unmarshalOTagFilterInput2ᚖgithubᚗcomᚋeveisesiᚋneoᚋgraphqlᚋmodelsᚐTagFilterInput(ctx context.Context, v interface{}) (*models.TagFilterInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputTagFilterInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v interface{}) (*time.Time, error) {
 	if v == nil {
 		return nil, nil
@@ -10447,6 +17610,27 @@ func (ec *executionContext) marshalOTypeFlag2ᚖgithubᚗcomᚋeveisesiᚋneoᚐ
 	return ec._TypeFlag(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOTypeGroup2ᚖgithubᚗcomᚋeveisesiᚋneoᚐTypeGroup(ctx context.Context, sel ast.SelectionSet, v *neo.TypeGroup) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TypeGroup(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOViewer2ᚖgithubᚗcomᚋeveisesiᚋneoᚐViewer(ctx context.Context, sel ast.SelectionSet, v *neo.Viewer) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Viewer(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOWatchlistEntry2ᚖgithubᚗcomᚋeveisesiᚋneoᚐWatchlistEntry(ctx context.Context, sel ast.SelectionSet, v *neo.WatchlistEntry) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._WatchlistEntry(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
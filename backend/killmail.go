@@ -2,6 +2,9 @@ package neo
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 )
 
@@ -10,10 +13,14 @@ type KillmailRepository interface {
 	Killmails(ctx context.Context, operators ...*Operator) ([]*Killmail, error)
 	CountKillmails(ctx context.Context, operators ...*Operator) (int64, error)
 	CreateKillmail(ctx context.Context, killmail *Killmail) error
-	// Update(ctx context.Context, id uint, killmail *Killmail) error
+	UpdateKillmail(ctx context.Context, id uint, killmail *Killmail) error
+	KillmailsBySolarSystemAndTime(ctx context.Context, solarSystemID uint, from, to time.Time) ([]*Killmail, error)
+	KillmailsByConstellationAndTime(ctx context.Context, constellationID uint, from, to time.Time) ([]*Killmail, error)
+	KillmailTotals(ctx context.Context, operators ...*Operator) (*KillmailTotals, error)
+	KillmailActivity(ctx context.Context, operators ...*Operator) ([]*ActivityCount, error)
+	KillmailCounts(ctx context.Context, column string, attackers []*Operator, byValue bool, limit int64, operators ...*Operator) ([]*KillmailCount, error)
 
 	Exists(ctx context.Context, id uint) (bool, error)
-	// Recalculable(ctx context.Context, limit int, after uint) ([]*Killmail, error)
 
 	KillHashesByDate(ctx context.Context, date time.Time) ([]*KillHash, error)
 	CreateHash(ctx context.Context, hash *KillHash) error
@@ -21,6 +28,22 @@ type KillmailRepository interface {
 	DeleteHashesByDate(ctx context.Context, date time.Time) error
 }
 
+// KillmailTotals sums up the killmails matching a set of operators
+type KillmailTotals struct {
+	Count      uint    `bson:"count" json:"count"`
+	TotalValue float64 `bson:"totalValue" json:"totalValue"`
+	Solo       uint    `bson:"solo" json:"solo"`
+	// Attackers is the number of player attackers across the killmails
+	Attackers uint `bson:"attackers" json:"attackers"`
+}
+
+// KillmailCount is the number of killmails, and the ISK they were worth, that share a value of a column
+type KillmailCount struct {
+	ID         uint64  `bson:"id" json:"id"`
+	Killmails  uint    `bson:"killmails" json:"killmails"`
+	TotalValue float64 `bson:"totalValue" json:"totalValue"`
+}
+
 type KillHash struct {
 	ID   uint      `bson:"id" json:"id"`
 	Hash string    `bson:"hash" json:"hash"`
@@ -32,8 +55,8 @@ type Killmail struct {
 	Hash            string    `bson:"hash" json:"hash"`
 	MoonID          *uint     `bson:"moonID,omitempty" json:"moonID,omitempty"`
 	SolarSystemID   uint      `bson:"solarSystemID" json:"solarSystemID"`
-	ConstellationID uint      `bson:"constellationID"`
-	RegionID        uint      `bson:"regionID"`
+	ConstellationID uint      `bson:"constellationID" json:"constellationID"`
+	RegionID        uint      `bson:"regionID" json:"regionID"`
 	WarID           *uint     `bson:"warID,omitempty" json:"warID,omitempty"`
 	IsNPC           bool      `bson:"isNPC" json:"isNPC"`
 	IsAwox          bool      `bson:"isAwox" json:"isAwox"`
@@ -44,6 +67,8 @@ type Killmail struct {
 	TotalValue      float64   `bson:"totalValue" json:"totalValue"`
 	KillmailTime    time.Time `bson:"killmailTime" json:"killmailTime"`
 
+	Tags []KillmailTag `bson:"tags" json:"tags"`
+
 	System    *SolarSystem        `bson:"-" json:"-"`
 	Attackers []*KillmailAttacker `bson:"attackers" json:"attackers"`
 	Victim    *KillmailVictim     `bson:"victim" json:"victim"`
@@ -111,3 +136,165 @@ type Position struct {
 	Y float64 `bson:"y" json:"y"`
 	Z float64 `bson:"z" json:"z"`
 }
+
+// KillmailTag classifies a killmail. Tags are assigned by the classifier rules registered with the killmail service
+type KillmailTag string
+
+const (
+	KillmailTagAwox        KillmailTag = "awox"
+	KillmailTagNPC         KillmailTag = "npc"
+	KillmailTagSolo        KillmailTag = "solo"
+	KillmailTagHighsecGank KillmailTag = "highsecGank"
+	KillmailTagCapital     KillmailTag = "capital"
+	KillmailTagStructure   KillmailTag = "structure"
+	KillmailTagPod         KillmailTag = "pod"
+	KillmailTagWormhole    KillmailTag = "wormhole"
+	KillmailTagSmallGang   KillmailTag = "smallGang"
+	KillmailTagBlob        KillmailTag = "blob"
+)
+
+var AllKillmailTags = []KillmailTag{
+	KillmailTagAwox,
+	KillmailTagNPC,
+	KillmailTagSolo,
+	KillmailTagHighsecGank,
+	KillmailTagCapital,
+	KillmailTagStructure,
+	KillmailTagPod,
+	KillmailTagWormhole,
+	KillmailTagSmallGang,
+	KillmailTagBlob,
+}
+
+func (e KillmailTag) IsValid() bool {
+	switch e {
+	case KillmailTagAwox, KillmailTagNPC, KillmailTagSolo,
+		KillmailTagHighsecGank, KillmailTagCapital, KillmailTagStructure, KillmailTagPod,
+		KillmailTagWormhole, KillmailTagSmallGang, KillmailTagBlob:
+		return true
+	}
+	return false
+}
+
+func (e KillmailTag) String() string {
+	return string(e)
+}
+
+func (e *KillmailTag) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = KillmailTag(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid KillmailTag", str)
+	}
+	return nil
+}
+
+func (e KillmailTag) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// HasTag reports whether the killmail carries the provided tag
+func (k *Killmail) HasTag(tag KillmailTag) bool {
+	for _, t := range k.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// RelatedKillmails groups the killmails that happened around a killmail by the alliance of their victims.
+// AllianceID is nil for the group of victims that are not in an alliance
+type RelatedKillmails struct {
+	AllianceID *uint       `json:"allianceID"`
+	TotalValue float64     `json:"totalValue"`
+	Killmails  []*Killmail `json:"killmails"`
+}
+
+// RelatedRadius determines how far away from a killmail related killmails are searched for
+type RelatedRadius string
+
+const (
+	RelatedRadiusSystem        RelatedRadius = "system"
+	RelatedRadiusConstellation RelatedRadius = "constellation"
+)
+
+var AllRelatedRadii = []RelatedRadius{
+	RelatedRadiusSystem,
+	RelatedRadiusConstellation,
+}
+
+func (e RelatedRadius) IsValid() bool {
+	switch e {
+	case RelatedRadiusSystem, RelatedRadiusConstellation:
+		return true
+	}
+	return false
+}
+
+func (e RelatedRadius) String() string {
+	return string(e)
+}
+
+func (e *RelatedRadius) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RelatedRadius(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RelatedRadius", str)
+	}
+	return nil
+}
+
+func (e RelatedRadius) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// KillmailSide is the side of a killmail an entity was on
+type KillmailSide string
+
+const (
+	KillmailSideAttacker KillmailSide = "attacker"
+	KillmailSideVictim   KillmailSide = "victim"
+)
+
+var AllKillmailSides = []KillmailSide{
+	KillmailSideAttacker,
+	KillmailSideVictim,
+}
+
+func (e KillmailSide) IsValid() bool {
+	switch e {
+	case KillmailSideAttacker, KillmailSideVictim:
+		return true
+	}
+	return false
+}
+
+func (e KillmailSide) String() string {
+	return string(e)
+}
+
+func (e *KillmailSide) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = KillmailSide(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid KillmailSide", str)
+	}
+	return nil
+}
+
+func (e KillmailSide) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,129 @@
+package neo
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Leaderboard ranks the entities of a category by their kills over a period. Entities are credited with a kill
+// when one of their attackers is on the killmail, and locations when the kill happened in them. A scoped
+// leaderboard only counts the attackers, or the killmails for location scopes, of its scope entity
+type Leaderboard struct {
+	Category    StatEntity          `json:"category"`
+	Scope       StatEntity          `json:"scope,omitempty"`
+	ScopeID     uint64              `json:"scopeID,omitempty"`
+	Period      LeaderboardPeriod   `json:"period"`
+	Metric      LeaderboardMetric   `json:"metric"`
+	Entries     []*LeaderboardEntry `json:"entries"`
+	GeneratedAt time.Time           `json:"generatedAt"`
+}
+
+type LeaderboardEntry struct {
+	// Category is copied from the leaderboard so that the entry can be resolved to its entity
+	Category     StatEntity `json:"-"`
+	ID           uint64     `json:"id"`
+	Kills        uint       `json:"kills"`
+	ISKDestroyed float64    `json:"iskDestroyed"`
+}
+
+type LeaderboardPeriod string
+
+const (
+	LeaderboardPeriodLastDay    LeaderboardPeriod = "lastDay"
+	LeaderboardPeriodLast7Days  LeaderboardPeriod = "last7Days"
+	LeaderboardPeriodLast30Days LeaderboardPeriod = "last30Days"
+	LeaderboardPeriodLast90Days LeaderboardPeriod = "last90Days"
+)
+
+var AllLeaderboardPeriods = []LeaderboardPeriod{
+	LeaderboardPeriodLastDay,
+	LeaderboardPeriodLast7Days,
+	LeaderboardPeriodLast30Days,
+	LeaderboardPeriodLast90Days,
+}
+
+func (e LeaderboardPeriod) IsValid() bool {
+	switch e {
+	case LeaderboardPeriodLastDay, LeaderboardPeriodLast7Days, LeaderboardPeriodLast30Days, LeaderboardPeriodLast90Days:
+		return true
+	}
+	return false
+}
+
+func (e LeaderboardPeriod) String() string {
+	return string(e)
+}
+
+// Days returns the number of days the period covers
+func (e LeaderboardPeriod) Days() int {
+	switch e {
+	case LeaderboardPeriodLast7Days:
+		return 7
+	case LeaderboardPeriodLast30Days:
+		return 30
+	case LeaderboardPeriodLast90Days:
+		return 90
+	}
+	return 1
+}
+
+func (e *LeaderboardPeriod) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LeaderboardPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LeaderboardPeriod", str)
+	}
+	return nil
+}
+
+func (e LeaderboardPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// LeaderboardMetric is what a leaderboard is ranked by
+type LeaderboardMetric string
+
+const (
+	LeaderboardMetricKills        LeaderboardMetric = "kills"
+	LeaderboardMetricISKDestroyed LeaderboardMetric = "iskDestroyed"
+)
+
+var AllLeaderboardMetrics = []LeaderboardMetric{
+	LeaderboardMetricKills,
+	LeaderboardMetricISKDestroyed,
+}
+
+func (e LeaderboardMetric) IsValid() bool {
+	switch e {
+	case LeaderboardMetricKills, LeaderboardMetricISKDestroyed:
+		return true
+	}
+	return false
+}
+
+func (e LeaderboardMetric) String() string {
+	return string(e)
+}
+
+func (e *LeaderboardMetric) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LeaderboardMetric(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LeaderboardMetric", str)
+	}
+	return nil
+}
+
+func (e LeaderboardMetric) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
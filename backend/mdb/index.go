@@ -0,0 +1,133 @@
+package mdb
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// indexes lists the secondary indexes that repository queries depend on, keyed by collection
+var indexes = map[string][]mongo.IndexModel{
+	"killmails": {
+		{
+			Keys:    primitive.D{{Key: "solarSystemID", Value: 1}, {Key: "killmailTime", Value: 1}},
+			Options: options.Index().SetName("solarSystemID_killmailTime"),
+		},
+		{
+			Keys:    primitive.D{{Key: "constellationID", Value: 1}, {Key: "killmailTime", Value: 1}},
+			Options: options.Index().SetName("constellationID_killmailTime"),
+		},
+		// Killmail connections seek to their (killmailTime, id) cursor and read the page in index order
+		{
+			Keys:    primitive.D{{Key: "victim.characterID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("victim.characterID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "attackers.characterID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("attackers.characterID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "victim.corporationID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("victim.corporationID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "attackers.corporationID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("attackers.corporationID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "victim.allianceID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("victim.allianceID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "attackers.allianceID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("attackers.allianceID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "regionID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("regionID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "victim.shipTypeID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("victim.shipTypeID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "attackers.shipTypeID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("attackers.shipTypeID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "victim.shipGroupID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("victim.shipGroupID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "attackers.shipGroupID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("attackers.shipGroupID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "solarSystemID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("solarSystemID_killmailTime_id"),
+		},
+		{
+			Keys:    primitive.D{{Key: "constellationID", Value: 1}, {Key: "killmailTime", Value: -1}, {Key: "id", Value: -1}},
+			Options: options.Index().SetName("constellationID_killmailTime_id"),
+		},
+	},
+	"notificationDeliveries": {
+		{
+			Keys:    primitive.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id").SetUnique(true),
+		},
+		{
+			Keys:    primitive.D{{Key: "killmailID", Value: 1}, {Key: "destination.type", Value: 1}, {Key: "destination.url", Value: 1}},
+			Options: options.Index().SetName("killmailID_destination").SetUnique(true),
+		},
+		{
+			Keys:    primitive.D{{Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("createdAt"),
+		},
+		{
+			Keys:    primitive.D{{Key: "status", Value: 1}, {Key: "updatedAt", Value: 1}},
+			Options: options.Index().SetName("status_updatedAt"),
+		},
+	},
+	"notificationRules": {
+		{
+			Keys:    primitive.D{{Key: "id", Value: 1}},
+			Options: options.Index().SetName("id").SetUnique(true),
+		},
+	},
+	"stats": {
+		{
+			Keys: primitive.D{
+				{Key: "entityType", Value: 1},
+				{Key: "entityID", Value: 1},
+				{Key: "category", Value: 1},
+				{Key: "frequency", Value: 1},
+				{Key: "date", Value: 1},
+			},
+			Options: options.Index().SetName("entityType_entityID_category_frequency_date").SetUnique(true),
+		},
+	},
+	"watchlists": {
+		{
+			Keys:    primitive.D{{Key: "accountID", Value: 1}, {Key: "entity", Value: 1}, {Key: "entityID", Value: 1}},
+			Options: options.Index().SetName("accountID_entity_entityID").SetUnique(true),
+		},
+	},
+}
+
+// CreateIndexes creates any missing indexes. Indexes that already exist with the same definition are left untouched
+func CreateIndexes(ctx context.Context, d *mongo.Database) error {
+
+	for collection, models := range indexes {
+		_, err := d.Collection(collection).Indexes().CreateMany(ctx, models)
+		if err != nil {
+			return errors.Wrapf(err, "failed to create indexes on %s", collection)
+		}
+	}
+
+	return nil
+
+}
@@ -2,11 +2,13 @@ package mdb
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/eveisesi/neo"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type killmailRepository struct {
@@ -74,6 +76,178 @@ func (r *killmailRepository) CreateKillmail(ctx context.Context, killmail *neo.K
 
 }
 
+func (r *killmailRepository) UpdateKillmail(ctx context.Context, id uint, killmail *neo.Killmail) error {
+
+	_, err := r.killmails.ReplaceOne(ctx, primitive.D{primitive.E{Key: "id", Value: id}}, killmail)
+
+	return err
+
+}
+
+// KillmailsBySolarSystemAndTime returns the killmails in a solar system between from and to inclusive, oldest first.
+// This query is backed by the solarSystemID_killmailTime index
+func (r *killmailRepository) KillmailsBySolarSystemAndTime(ctx context.Context, solarSystemID uint, from, to time.Time) ([]*neo.Killmail, error) {
+	return r.Killmails(
+		ctx,
+		neo.NewEqualOperator("solarSystemID", solarSystemID),
+		neo.NewGreaterThanEqualToOperator("killmailTime", from),
+		neo.NewLessThanEqualToOperator("killmailTime", to),
+		neo.NewOrderOperator("killmailTime", neo.SortAsc),
+	)
+}
+
+// KillmailsByConstellationAndTime returns the killmails in a constellation between from and to inclusive, oldest first.
+// This query is backed by the constellationID_killmailTime index
+func (r *killmailRepository) KillmailsByConstellationAndTime(ctx context.Context, constellationID uint, from, to time.Time) ([]*neo.Killmail, error) {
+	return r.Killmails(
+		ctx,
+		neo.NewEqualOperator("constellationID", constellationID),
+		neo.NewGreaterThanEqualToOperator("killmailTime", from),
+		neo.NewLessThanEqualToOperator("killmailTime", to),
+		neo.NewOrderOperator("killmailTime", neo.SortAsc),
+	)
+}
+
+func (r *killmailRepository) KillmailTotals(ctx context.Context, operators ...*neo.Operator) (*neo.KillmailTotals, error) {
+
+	pilots := primitive.D{primitive.E{Key: "$size", Value: primitive.D{primitive.E{Key: "$filter", Value: primitive.D{
+		primitive.E{Key: "input", Value: "$attackers"},
+		primitive.E{Key: "as", Value: "attacker"},
+		primitive.E{Key: "cond", Value: primitive.D{primitive.E{Key: "$gt", Value: primitive.A{
+			primitive.D{primitive.E{Key: "$ifNull", Value: primitive.A{"$$attacker.characterID", 0}}},
+			0,
+		}}}},
+	}}}}}
+
+	solo := primitive.D{primitive.E{Key: "$cond", Value: primitive.A{"$isSolo", 1, 0}}}
+
+	pipeline := mongo.Pipeline{
+		primitive.D{primitive.E{Key: "$match", Value: BuildFilters(operators...)}},
+		primitive.D{primitive.E{Key: "$group", Value: primitive.D{
+			primitive.E{Key: "_id", Value: nil},
+			primitive.E{Key: "count", Value: primitive.D{primitive.E{Key: "$sum", Value: 1}}},
+			primitive.E{Key: "totalValue", Value: primitive.D{primitive.E{Key: "$sum", Value: "$totalValue"}}},
+			primitive.E{Key: "solo", Value: primitive.D{primitive.E{Key: "$sum", Value: solo}}},
+			primitive.E{Key: "attackers", Value: primitive.D{primitive.E{Key: "$sum", Value: pilots}}},
+		}}},
+	}
+
+	result, err := r.killmails.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var totals = make([]*neo.KillmailTotals, 0)
+	err = result.All(ctx, &totals)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(totals) == 0 {
+		return new(neo.KillmailTotals), nil
+	}
+
+	return totals[0], nil
+
+}
+
+// KillmailActivity counts the killmails matching the operators by the day of the week and hour of their killmail time
+func (r *killmailRepository) KillmailActivity(ctx context.Context, operators ...*neo.Operator) ([]*neo.ActivityCount, error) {
+
+	pipeline := mongo.Pipeline{
+		primitive.D{primitive.E{Key: "$match", Value: BuildFilters(operators...)}},
+		primitive.D{primitive.E{Key: "$group", Value: primitive.D{
+			primitive.E{Key: "_id", Value: primitive.D{
+				// $dayOfWeek counts from 1 on Sunday, time.Weekday from 0
+				primitive.E{Key: "weekday", Value: primitive.D{primitive.E{Key: "$subtract", Value: primitive.A{
+					primitive.D{primitive.E{Key: "$dayOfWeek", Value: "$killmailTime"}},
+					1,
+				}}}},
+				primitive.E{Key: "hour", Value: primitive.D{primitive.E{Key: "$hour", Value: "$killmailTime"}}},
+			}},
+			primitive.E{Key: "count", Value: primitive.D{primitive.E{Key: "$sum", Value: 1}}},
+		}}},
+		primitive.D{primitive.E{Key: "$project", Value: primitive.D{
+			primitive.E{Key: "_id", Value: 0},
+			primitive.E{Key: "weekday", Value: "$_id.weekday"},
+			primitive.E{Key: "hour", Value: "$_id.hour"},
+			primitive.E{Key: "count", Value: 1},
+		}}},
+	}
+
+	result, err := r.killmails.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var counts = make([]*neo.ActivityCount, 0)
+	err = result.All(ctx, &counts)
+	return counts, err
+
+}
+
+// KillmailCounts groups the killmails matching the operators by the value of column and returns the limit largest
+// groups, by number of killmails or by value. When column is on the attackers or attacker operators are given,
+// killmails are unwound to the attackers matching those operators, and every value is still only counted once per
+// killmail however many of the attackers share it
+func (r *killmailRepository) KillmailCounts(ctx context.Context, column string, attackers []*neo.Operator, byValue bool, limit int64, operators ...*neo.Operator) ([]*neo.KillmailCount, error) {
+
+	pipeline := mongo.Pipeline{
+		primitive.D{primitive.E{Key: "$match", Value: BuildFilters(operators...)}},
+	}
+
+	if strings.HasPrefix(column, "attackers.") || len(attackers) > 0 {
+		pipeline = append(pipeline,
+			primitive.D{primitive.E{Key: "$unwind", Value: "$attackers"}},
+			primitive.D{primitive.E{Key: "$match", Value: BuildFilters(attackers...)}},
+		)
+	}
+
+	sort := primitive.D{
+		primitive.E{Key: "killmails", Value: -1},
+		primitive.E{Key: "totalValue", Value: -1},
+	}
+	if byValue {
+		sort[0], sort[1] = sort[1], sort[0]
+	}
+
+	pipeline = append(pipeline,
+		primitive.D{primitive.E{Key: "$group", Value: primitive.D{
+			primitive.E{Key: "_id", Value: primitive.D{
+				primitive.E{Key: "killmail", Value: "$id"},
+				primitive.E{Key: "value", Value: "$" + column},
+			}},
+			primitive.E{Key: "totalValue", Value: primitive.D{primitive.E{Key: "$first", Value: "$totalValue"}}},
+		}}},
+		primitive.D{primitive.E{Key: "$match", Value: primitive.D{
+			primitive.E{Key: "_id.value", Value: primitive.D{primitive.E{Key: "$gt", Value: 0}}},
+		}}},
+		primitive.D{primitive.E{Key: "$group", Value: primitive.D{
+			primitive.E{Key: "_id", Value: "$_id.value"},
+			primitive.E{Key: "killmails", Value: primitive.D{primitive.E{Key: "$sum", Value: 1}}},
+			primitive.E{Key: "totalValue", Value: primitive.D{primitive.E{Key: "$sum", Value: "$totalValue"}}},
+		}}},
+		primitive.D{primitive.E{Key: "$sort", Value: sort}},
+		primitive.D{primitive.E{Key: "$limit", Value: limit}},
+		primitive.D{primitive.E{Key: "$project", Value: primitive.D{
+			primitive.E{Key: "_id", Value: 0},
+			primitive.E{Key: "id", Value: "$_id"},
+			primitive.E{Key: "killmails", Value: 1},
+			primitive.E{Key: "totalValue", Value: 1},
+		}}},
+	)
+
+	result, err := r.killmails.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, err
+	}
+
+	var counts = make([]*neo.KillmailCount, 0)
+	err = result.All(ctx, &counts)
+	return counts, err
+
+}
+
 func (r *killmailRepository) Exists(ctx context.Context, id uint) (bool, error) {
 
 	count, err := r.killmails.CountDocuments(ctx, primitive.D{primitive.E{Key: "id", Value: id}})
@@ -126,6 +126,7 @@ func BuildFilters(operators ...*neo.Operator) primitive.D {
 
 func BuildFindOptions(ops ...*neo.Operator) *options.FindOptions {
 	var opts = options.Find()
+	var sort = make(primitive.D, 0)
 	for _, a := range ops {
 		switch a.Operation {
 		case neo.LimitOp:
@@ -133,10 +134,15 @@ func BuildFindOptions(ops ...*neo.Operator) *options.FindOptions {
 		case neo.SkipOp:
 			opts.SetSkip(a.Value.(int64))
 		case neo.OrderOp:
-			opts.SetSort(primitive.D{primitive.E{Key: a.Column, Value: a.Value}})
+			// Order operators are applied in the order they are given, later ones break ties of earlier ones
+			sort = append(sort, primitive.E{Key: a.Column, Value: a.Value})
 		}
 	}
 
+	if len(sort) > 0 {
+		opts.SetSort(sort)
+	}
+
 	return opts
 }
 
@@ -0,0 +1,88 @@
+package mdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type notificationRuleRepository struct {
+	c *mongo.Collection
+}
+
+func NewNotificationRuleRepository(d *mongo.Database) neo.NotificationRuleRepository {
+	return &notificationRuleRepository{
+		d.Collection("notificationRules"),
+	}
+}
+
+func (r *notificationRuleRepository) NotificationRule(ctx context.Context, id string) (*neo.NotificationRule, error) {
+
+	var rule = new(neo.NotificationRule)
+
+	err := r.c.FindOne(ctx, primitive.D{primitive.E{Key: "id", Value: id}}).Decode(rule)
+	return rule, err
+
+}
+
+func (r *notificationRuleRepository) NotificationRules(ctx context.Context, operators ...*neo.Operator) ([]*neo.NotificationRule, error) {
+
+	filters := BuildFilters(operators...)
+	options := BuildFindOptions(operators...)
+
+	var rules = make([]*neo.NotificationRule, 0)
+	result, err := r.c.Find(ctx, filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = result.All(ctx, &rules)
+	return rules, err
+
+}
+
+// CreateNotificationRule inserts the rule, assigning it a new id
+func (r *notificationRuleRepository) CreateNotificationRule(ctx context.Context, rule *neo.NotificationRule) (*neo.NotificationRule, error) {
+
+	rule.ID = primitive.NewObjectID().Hex()
+	rule.CreatedAt = time.Now()
+	rule.UpdatedAt = time.Now()
+
+	_, err := r.c.InsertOne(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+
+}
+
+func (r *notificationRuleRepository) UpdateNotificationRule(ctx context.Context, id string, rule *neo.NotificationRule) (*neo.NotificationRule, error) {
+
+	rule.ID = id
+	rule.UpdatedAt = time.Now()
+
+	update := primitive.D{primitive.E{Key: "$set", Value: rule}}
+
+	_, err := r.c.UpdateOne(ctx, primitive.D{{Key: "id", Value: id}}, update, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+
+}
+
+func (r *notificationRuleRepository) DeleteNotificationRule(ctx context.Context, id string) (bool, error) {
+
+	result, err := r.c.DeleteOne(ctx, primitive.D{{Key: "id", Value: id}})
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+
+}
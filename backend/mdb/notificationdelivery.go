@@ -0,0 +1,125 @@
+package mdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type notificationDeliveryRepository struct {
+	c *mongo.Collection
+}
+
+func NewNotificationDeliveryRepository(d *mongo.Database) neo.NotificationDeliveryRepository {
+	return &notificationDeliveryRepository{
+		d.Collection("notificationDeliveries"),
+	}
+}
+
+func (r *notificationDeliveryRepository) NotificationDelivery(ctx context.Context, id string) (*neo.NotificationDelivery, error) {
+
+	var delivery = new(neo.NotificationDelivery)
+
+	err := r.c.FindOne(ctx, primitive.D{primitive.E{Key: "id", Value: id}}).Decode(delivery)
+	return delivery, err
+
+}
+
+func (r *notificationDeliveryRepository) NotificationDeliveries(ctx context.Context, operators ...*neo.Operator) ([]*neo.NotificationDelivery, error) {
+
+	filters := BuildFilters(operators...)
+	options := BuildFindOptions(operators...)
+
+	var deliveries = make([]*neo.NotificationDelivery, 0)
+	result, err := r.c.Find(ctx, filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = result.All(ctx, &deliveries)
+	return deliveries, err
+
+}
+
+// CreateNotificationDelivery relies on the unique killmailID_destination index to detect duplicate deliveries
+func (r *notificationDeliveryRepository) CreateNotificationDelivery(ctx context.Context, delivery *neo.NotificationDelivery) (bool, error) {
+
+	delivery.ID = primitive.NewObjectID().Hex()
+	delivery.CreatedAt = time.Now()
+	delivery.UpdatedAt = time.Now()
+
+	_, err := r.c.InsertOne(ctx, delivery)
+	if err != nil {
+		if IsUniqueConstrainViolation(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+
+}
+
+func (r *notificationDeliveryRepository) UpdateNotificationDelivery(ctx context.Context, id string, delivery *neo.NotificationDelivery) (*neo.NotificationDelivery, error) {
+
+	delivery.ID = id
+	delivery.UpdatedAt = time.Now()
+
+	update := primitive.D{primitive.E{Key: "$set", Value: delivery}}
+
+	_, err := r.c.UpdateOne(ctx, primitive.D{{Key: "id", Value: id}}, update, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+
+}
+
+func (r *notificationDeliveryRepository) ClaimNotificationDelivery(ctx context.Context, id string, attempts uint, staleBefore time.Time) (bool, error) {
+
+	filter := primitive.D{
+		primitive.E{Key: "id", Value: id},
+		primitive.E{Key: "attempts", Value: attempts},
+		primitive.E{Key: "$or", Value: primitive.A{
+			primitive.D{primitive.E{Key: "status", Value: primitive.D{primitive.E{Key: "$in", Value: primitive.A{
+				neo.NotificationDeliveryPending,
+				neo.NotificationDeliveryRetrying,
+			}}}}},
+			primitive.D{
+				primitive.E{Key: "status", Value: neo.NotificationDeliverySending},
+				primitive.E{Key: "updatedAt", Value: primitive.D{primitive.E{Key: "$lt", Value: staleBefore}}},
+			},
+		}},
+	}
+
+	update := primitive.D{primitive.E{Key: "$set", Value: primitive.D{
+		primitive.E{Key: "status", Value: neo.NotificationDeliverySending},
+		primitive.E{Key: "updatedAt", Value: time.Now()},
+	}}}
+
+	result, err := r.c.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+
+	return result.ModifiedCount > 0, nil
+
+}
+
+func (r *notificationDeliveryRepository) TouchNotificationDelivery(ctx context.Context, id string, status neo.NotificationDeliveryStatus) error {
+
+	filter := primitive.D{
+		primitive.E{Key: "id", Value: id},
+		primitive.E{Key: "status", Value: status},
+	}
+
+	update := primitive.D{primitive.E{Key: "$set", Value: primitive.D{primitive.E{Key: "updatedAt", Value: time.Now()}}}}
+
+	_, err := r.c.UpdateOne(ctx, filter, update)
+	return err
+
+}
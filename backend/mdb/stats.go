@@ -0,0 +1,177 @@
+package mdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// statsWriteBatch caps the number of counters written in a single bulk write
+const statsWriteBatch = 1000
+
+type statsRepository struct {
+	c *mongo.Collection
+}
+
+func NewStatsRepository(d *mongo.Database) neo.StatsRepository {
+	return &statsRepository{
+		d.Collection("stats"),
+	}
+}
+
+func (r *statsRepository) AllStats(ctx context.Context, operators ...*neo.Operator) ([]*neo.Stat, error) {
+
+	filters := BuildFilters(operators...)
+	options := BuildFindOptions(operators...)
+
+	var stats = make([]*neo.Stat, 0)
+	result, err := r.c.Find(ctx, filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = result.All(ctx, &stats)
+	return stats, err
+
+}
+
+// IncrementStats upserts every counter, relying on the unique counter index so that concurrent increments of a
+// counter that does not exist yet cannot create it twice
+func (r *statsRepository) IncrementStats(ctx context.Context, stats []*neo.Stat) error {
+
+	now := time.Now()
+
+	models := make([]mongo.WriteModel, 0, len(stats))
+	for _, stat := range stats {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(statFilter(stat)).
+			SetUpdate(primitive.D{
+				primitive.E{Key: "$inc", Value: primitive.D{primitive.E{Key: "value", Value: stat.Value}}},
+				primitive.E{Key: "$set", Value: primitive.D{primitive.E{Key: "updatedAt", Value: now}}},
+				primitive.E{Key: "$setOnInsert", Value: primitive.D{primitive.E{Key: "createdAt", Value: now}}},
+			}).
+			SetUpsert(true),
+		)
+	}
+
+	return r.write(ctx, models)
+
+}
+
+func (r *statsRepository) DeleteStats(ctx context.Context, operators ...*neo.Operator) error {
+
+	filters := BuildFilters(operators...)
+
+	_, err := r.c.DeleteMany(ctx, filters)
+	return err
+
+}
+
+func (r *statsRepository) RollupAlltimeStats(ctx context.Context, entity neo.StatEntity) error {
+
+	pipeline := mongo.Pipeline{
+		primitive.D{primitive.E{Key: "$match", Value: primitive.D{
+			primitive.E{Key: "entityType", Value: entity},
+			primitive.E{Key: "frequency", Value: neo.StatFrequencyYearly},
+		}}},
+		primitive.D{primitive.E{Key: "$group", Value: primitive.D{
+			primitive.E{Key: "_id", Value: primitive.D{
+				primitive.E{Key: "entityID", Value: "$entityID"},
+				primitive.E{Key: "category", Value: "$category"},
+			}},
+			primitive.E{Key: "value", Value: primitive.D{primitive.E{Key: "$sum", Value: "$value"}}},
+		}}},
+	}
+
+	cursor, err := r.c.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	now := time.Now()
+
+	models := make([]mongo.WriteModel, 0, statsWriteBatch)
+	for cursor.Next(ctx) {
+		var total struct {
+			ID struct {
+				EntityID uint64           `bson:"entityID"`
+				Category neo.StatCategory `bson:"category"`
+			} `bson:"_id"`
+			Value float64 `bson:"value"`
+		}
+
+		err = cursor.Decode(&total)
+		if err != nil {
+			return err
+		}
+
+		stat := &neo.Stat{
+			EntityID:   total.ID.EntityID,
+			EntityType: entity,
+			Category:   total.ID.Category,
+			Frequency:  neo.StatFrequencyAlltime,
+		}
+
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(statFilter(stat)).
+			SetUpdate(primitive.D{
+				primitive.E{Key: "$set", Value: primitive.D{
+					primitive.E{Key: "value", Value: total.Value},
+					primitive.E{Key: "updatedAt", Value: now},
+				}},
+				primitive.E{Key: "$setOnInsert", Value: primitive.D{primitive.E{Key: "createdAt", Value: now}}},
+			}).
+			SetUpsert(true),
+		)
+
+		if len(models) == statsWriteBatch {
+			err = r.write(ctx, models)
+			if err != nil {
+				return err
+			}
+			models = models[:0]
+		}
+	}
+
+	if err = cursor.Err(); err != nil {
+		return err
+	}
+
+	return r.write(ctx, models)
+
+}
+
+func (r *statsRepository) write(ctx context.Context, models []mongo.WriteModel) error {
+
+	for len(models) > 0 {
+		end := statsWriteBatch
+		if end > len(models) {
+			end = len(models)
+		}
+
+		_, err := r.c.BulkWrite(ctx, models[:end], options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return err
+		}
+
+		models = models[end:]
+	}
+
+	return nil
+
+}
+
+func statFilter(stat *neo.Stat) primitive.D {
+	return primitive.D{
+		primitive.E{Key: "entityType", Value: stat.EntityType},
+		primitive.E{Key: "entityID", Value: stat.EntityID},
+		primitive.E{Key: "category", Value: stat.Category},
+		primitive.E{Key: "frequency", Value: stat.Frequency},
+		primitive.E{Key: "date", Value: stat.Date},
+	}
+}
@@ -0,0 +1,93 @@
+package mdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type tokenRepository struct {
+	c *mongo.Collection
+}
+
+func NewTokenRepository(d *mongo.Database) neo.TokenRepository {
+	return &tokenRepository{
+		d.Collection("tokens"),
+	}
+}
+
+func (r *tokenRepository) Token(ctx context.Context, id uint64) (*neo.Token, error) {
+
+	var token = new(neo.Token)
+
+	err := r.c.FindOne(ctx, primitive.D{primitive.E{Key: "id", Value: id}}).Decode(token)
+	return token, err
+
+}
+
+func (r *tokenRepository) Tokens(ctx context.Context, operators ...*neo.Operator) ([]*neo.Token, error) {
+
+	filters := BuildFilters(operators...)
+	options := BuildFindOptions(operators...)
+
+	var tokens = make([]*neo.Token, 0)
+	result, err := r.c.Find(ctx, filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = result.All(ctx, &tokens)
+	return tokens, err
+
+}
+
+func (r *tokenRepository) AccountTokens(ctx context.Context, main uint64) ([]*neo.Token, error) {
+	return r.Tokens(ctx, neo.NewOrOperator(
+		neo.NewEqualOperator("id", main),
+		neo.NewEqualOperator("main", main),
+	))
+}
+
+func (r *tokenRepository) CreateToken(ctx context.Context, token *neo.Token) (*neo.Token, error) {
+
+	token.CreatedAt = time.Now()
+	token.UpdatedAt = time.Now()
+
+	_, err := r.c.InsertOne(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+
+}
+
+func (r *tokenRepository) UpdateToken(ctx context.Context, id uint64, token *neo.Token) (*neo.Token, error) {
+
+	token.ID = id
+	token.UpdatedAt = time.Now()
+	if token.CreatedAt.IsZero() {
+		token.CreatedAt = time.Now()
+	}
+
+	update := primitive.D{primitive.E{Key: "$set", Value: token}}
+
+	_, err := r.c.UpdateOne(ctx, primitive.D{{Key: "id", Value: id}}, update, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+
+}
+
+func (r *tokenRepository) DeleteToken(ctx context.Context, id uint64) error {
+
+	_, err := r.c.DeleteOne(ctx, primitive.D{{Key: "id", Value: id}})
+
+	return err
+
+}
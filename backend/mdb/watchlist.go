@@ -0,0 +1,74 @@
+package mdb
+
+import (
+	"context"
+
+	"github.com/eveisesi/neo"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type watchlistRepository struct {
+	c *mongo.Collection
+}
+
+func NewWatchlistRepository(d *mongo.Database) neo.WatchlistRepository {
+	return &watchlistRepository{
+		d.Collection("watchlists"),
+	}
+}
+
+func (r *watchlistRepository) Watchlist(ctx context.Context, accountID uint64) ([]*neo.WatchlistEntry, error) {
+
+	filters := BuildFilters(neo.NewEqualOperator("accountID", accountID))
+	options := BuildFindOptions(neo.NewOrderOperator("createdAt", neo.SortAsc))
+
+	var entries = make([]*neo.WatchlistEntry, 0)
+	result, err := r.c.Find(ctx, filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = result.All(ctx, &entries)
+	return entries, err
+
+}
+
+func (r *watchlistRepository) CountWatchlist(ctx context.Context, accountID uint64) (int64, error) {
+	return r.c.CountDocuments(ctx, BuildFilters(neo.NewEqualOperator("accountID", accountID)))
+}
+
+// Watch adds the entry to the watchlist. Watching an entity that is already watched leaves the existing entry as is
+func (r *watchlistRepository) Watch(ctx context.Context, entry *neo.WatchlistEntry) error {
+
+	filter := primitive.D{
+		{Key: "accountID", Value: entry.AccountID},
+		{Key: "entity", Value: entry.Entity},
+		{Key: "entityID", Value: entry.EntityID},
+	}
+
+	update := primitive.D{primitive.E{Key: "$setOnInsert", Value: entry}}
+
+	_, err := r.c.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+
+	return err
+
+}
+
+func (r *watchlistRepository) Unwatch(ctx context.Context, accountID uint64, entity neo.WatchEntity, entityID uint64) (bool, error) {
+
+	filter := primitive.D{
+		{Key: "accountID", Value: accountID},
+		{Key: "entity", Value: entity},
+		{Key: "entityID", Value: entityID},
+	}
+
+	result, err := r.c.DeleteOne(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+
+	return result.DeletedCount > 0, nil
+
+}
@@ -0,0 +1,110 @@
+package neo
+
+import (
+	"context"
+	"time"
+
+	"github.com/volatiletech/null"
+)
+
+type NotificationRuleRepository interface {
+	NotificationRule(ctx context.Context, id string) (*NotificationRule, error)
+	NotificationRules(ctx context.Context, operators ...*Operator) ([]*NotificationRule, error)
+	CreateNotificationRule(ctx context.Context, rule *NotificationRule) (*NotificationRule, error)
+	UpdateNotificationRule(ctx context.Context, id string, rule *NotificationRule) (*NotificationRule, error)
+	DeleteNotificationRule(ctx context.Context, id string) (bool, error)
+}
+
+// NotificationRule sends killmails that meet its criteria to its destination
+type NotificationRule struct {
+	ID          string                  `bson:"id" json:"id"`
+	Name        string                  `bson:"name" json:"name"`
+	Enabled     bool                    `bson:"enabled" json:"enabled"`
+	Destination NotificationDestination `bson:"destination" json:"destination"`
+	Criteria    KillmailCriteria        `bson:"criteria" json:"criteria"`
+	CreatedAt   time.Time               `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time               `bson:"updatedAt" json:"updatedAt"`
+}
+
+// NotificationDestination is where a notification is delivered to
+type NotificationDestination struct {
+	Type NotificationDestinationType `bson:"type" json:"type"`
+	URL  string                      `bson:"url" json:"url"`
+	// Secret signs the payloads sent to generic webhooks so that receivers can verify where they came from
+	Secret string `bson:"secret,omitempty" json:"secret,omitempty"`
+}
+
+type NotificationDestinationType string
+
+const (
+	NotificationDestinationSlack   NotificationDestinationType = "slack"
+	NotificationDestinationDiscord NotificationDestinationType = "discord"
+	// NotificationDestinationWebhook receives the full killmail as JSON
+	NotificationDestinationWebhook NotificationDestinationType = "webhook"
+)
+
+var AllNotificationDestinationTypes = []NotificationDestinationType{
+	NotificationDestinationSlack,
+	NotificationDestinationDiscord,
+	NotificationDestinationWebhook,
+}
+
+func (e NotificationDestinationType) IsValid() bool {
+	switch e {
+	case NotificationDestinationSlack, NotificationDestinationDiscord, NotificationDestinationWebhook:
+		return true
+	}
+	return false
+}
+
+func (e NotificationDestinationType) String() string {
+	return string(e)
+}
+
+type NotificationDeliveryRepository interface {
+	NotificationDelivery(ctx context.Context, id string) (*NotificationDelivery, error)
+	NotificationDeliveries(ctx context.Context, operators ...*Operator) ([]*NotificationDelivery, error)
+	// CreateNotificationDelivery reports false, without an error, when the killmail already has a delivery to the destination
+	CreateNotificationDelivery(ctx context.Context, delivery *NotificationDelivery) (bool, error)
+	UpdateNotificationDelivery(ctx context.Context, id string, delivery *NotificationDelivery) (*NotificationDelivery, error)
+	// ClaimNotificationDelivery atomically marks a pending or retrying delivery that has been attempted attempts
+	// times as sending. A sending delivery that was claimed before staleBefore, i.e. by a consumer that died mid
+	// attempt, can be claimed again. It reports false when another consumer holds the claim or the delivery has settled
+	ClaimNotificationDelivery(ctx context.Context, id string, attempts uint, staleBefore time.Time) (bool, error)
+	// TouchNotificationDelivery bumps the updatedAt of the delivery, provided it still has the status
+	TouchNotificationDelivery(ctx context.Context, id string, status NotificationDeliveryStatus) error
+}
+
+// NotificationDelivery records the delivery of a killmail to a destination. A killmail is delivered to a
+// destination at most once, however many rules sending to that destination it matches
+type NotificationDelivery struct {
+	ID            string                     `bson:"id" json:"id"`
+	KillmailID    uint                       `bson:"killmailID" json:"killmailID"`
+	Hash          string                     `bson:"hash" json:"hash"`
+	RuleIDs       []string                   `bson:"ruleIDs" json:"ruleIDs"`
+	Destination   NotificationDestination    `bson:"destination" json:"destination"`
+	Status        NotificationDeliveryStatus `bson:"status" json:"status"`
+	Attempts      uint                       `bson:"attempts" json:"attempts"`
+	ResponseCode  int                        `bson:"responseCode" json:"responseCode"`
+	LastError     string                     `bson:"lastError" json:"lastError"`
+	LastAttemptAt null.Time                  `bson:"lastAttemptAt" json:"lastAttemptAt,omitempty"`
+	NextAttemptAt null.Time                  `bson:"nextAttemptAt" json:"nextAttemptAt,omitempty"`
+	CreatedAt     time.Time                  `bson:"createdAt" json:"createdAt"`
+	UpdatedAt     time.Time                  `bson:"updatedAt" json:"updatedAt"`
+}
+
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryPending  NotificationDeliveryStatus = "pending"
+	NotificationDeliveryRetrying NotificationDeliveryStatus = "retrying"
+	// NotificationDeliverySending marks a delivery that a consumer has claimed and is attempting
+	NotificationDeliverySending   NotificationDeliveryStatus = "sending"
+	NotificationDeliveryDelivered NotificationDeliveryStatus = "delivered"
+	// NotificationDeliveryFailed is final. The destination rejected the delivery or the retry budget ran out
+	NotificationDeliveryFailed NotificationDeliveryStatus = "failed"
+)
+
+func (e NotificationDeliveryStatus) String() string {
+	return string(e)
+}
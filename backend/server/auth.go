@@ -1,21 +1,50 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/services/token"
 	"github.com/eveisesi/neo/tools"
 	"github.com/sirupsen/logrus"
 )
 
+// authState is stored against an SSO state. Link states remember the character the alt is linked to along with the
+// session that asked for the link, so that only that session can complete it. A zero Main signs the character in
+// on its own
+type authState struct {
+	Main    uint64 `json:"main"`
+	Session string `json:"session"`
+}
+
 func (s *Server) handleGetState(w http.ResponseWriter, r *http.Request) {
 
 	random := tools.RandomString(32)
 
-	_, err := s.redis.Set(r.Context(), fmt.Sprintf("neo:state:%s", random), true, time.Minute*2).Result()
+	var state authState
+	if r.URL.Query().Get("link") == "true" {
+		viewer := CtxViewer(r.Context())
+		if viewer == nil {
+			s.WriteError(w, http.StatusUnauthorized, errors.New("linking a character requires a valid session"))
+			return
+		}
+		state.Main = viewer.CharacterID
+		state.Session = viewer.SessionID
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		s.WriteError(w, http.StatusInternalServerError, errors.New("unable to handle request at this time"))
+		return
+	}
+
+	_, err = s.redis.Set(r.Context(), fmt.Sprintf("neo:state:%s", random), data, time.Minute*2).Result()
 	if err != nil {
 		s.WriteError(w, http.StatusInternalServerError, errors.New("unable to handle request at this time"))
 		return
@@ -48,7 +77,7 @@ func (s *Server) handlePostCode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	key := fmt.Sprintf("neo:state:%s", state)
-	_, err := s.redis.Get(ctx, key).Result()
+	data, err := s.redis.Get(ctx, key).Bytes()
 	if err != nil {
 		if err.Error() == "redis: nil" {
 			err = errors.New("invalid state")
@@ -63,7 +92,29 @@ func (s *Server) handlePostCode(w http.ResponseWriter, r *http.Request) {
 
 	s.redis.Del(r.Context(), key)
 
-	token, err := s.token.GetTokenForCode(ctx, state, code)
+	var stored authState
+	err = json.Unmarshal(data, &stored)
+	if err != nil {
+		s.WriteError(w, http.StatusBadRequest, errors.New("invalid state"))
+		return
+	}
+
+	// A link state can only be completed by the session that created it. Otherwise anybody handed the link url
+	// would have their character linked to, and be signed in as, the account that created it
+	main := stored.Main
+	if main > 0 {
+		err = s.authorizeLink(ctx, stored)
+		if err != nil {
+			s.WriteError(w, http.StatusForbidden, err)
+			return
+		}
+	}
+
+	ssoToken, err := s.token.GetTokenForCode(ctx, state, code, main)
+	if errors.Is(err, token.ErrAccountHasAlts) {
+		s.WriteError(w, http.StatusConflict, err)
+		return
+	}
 	if err != nil {
 		msg := "failed to trade code for token"
 		s.logger.WithError(err).Error(msg)
@@ -71,7 +122,102 @@ func (s *Server) handlePostCode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("X-Neo-Token", token.AccessToken)
+	// Linking an alt keeps the viewer signed in as the character they started from
+	viewerID := ssoToken.ID
+	if main > 0 {
+		viewerID = main
+	}
+
+	session, err := s.token.IssueSession(ctx, viewerID)
+	if err != nil {
+		msg := "failed to issue session"
+		s.logger.WithError(err).Error(msg)
+		s.WriteError(w, http.StatusInternalServerError, errors.New(msg))
+		return
+	}
+
+	w.Header().Set("X-Neo-Token", session)
 	w.WriteHeader(http.StatusNoContent)
 
 }
+
+var errLinkForbidden = errors.New("character link was not requested by this session")
+
+// authorizeLink checks that the viewer completing a link state is the session that created it and still belongs
+// to the account the character is being linked to
+func (s *Server) authorizeLink(ctx context.Context, state authState) error {
+
+	viewer := CtxViewer(ctx)
+	if viewer == nil || viewer.SessionID != state.Session {
+		return errLinkForbidden
+	}
+
+	viewerMain, err := s.token.AccountMain(ctx, viewer.CharacterID)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to resolve account of viewer")
+		return errLinkForbidden
+	}
+
+	main, err := s.token.AccountMain(ctx, state.Main)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to resolve account of link state")
+		return errLinkForbidden
+	}
+
+	if viewerMain != main {
+		return errLinkForbidden
+	}
+
+	return nil
+
+}
+
+var viewerCtxKey = ctxKeyType{"viewer"}
+
+// Authenticate resolves the session token in the Authorization header into a viewer on the request context.
+// Requests without an Authorization header continue anonymously, while requests with an invalid or revoked
+// session are rejected so that the client knows to sign in again
+func (s *Server) Authenticate(next http.Handler) http.Handler {
+	return s.authenticate(next, true)
+}
+
+// AuthenticateOptional behaves like Authenticate but lets requests with an invalid or revoked session continue
+// anonymously. The sign in routes use it so that a client holding an expired session can still sign in again
+func (s *Server) AuthenticateOptional(next http.Handler) http.Handler {
+	return s.authenticate(next, false)
+}
+
+func (s *Server) authenticate(next http.Handler, strict bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		raw := strings.TrimPrefix(header, "Bearer ")
+		viewer, err := s.token.Session(r.Context(), raw)
+		if err != nil {
+			if !errors.Is(err, token.ErrInvalidSession) {
+				s.logger.WithError(err).Error("failed to resolve session")
+			}
+			if !strict {
+				next.ServeHTTP(w, r)
+				return
+			}
+			s.WriteError(w, http.StatusUnauthorized, token.ErrInvalidSession)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), viewerCtxKey, viewer)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// CtxViewer returns the viewer of an authenticated request, or nil for anonymous requests
+func CtxViewer(ctx context.Context) *neo.Viewer {
+	viewer, _ := ctx.Value(viewerCtxKey).(*neo.Viewer)
+	return viewer
+}
@@ -27,12 +27,16 @@ import (
 	"github.com/eveisesi/neo/graphql/resolvers"
 	"github.com/eveisesi/neo/graphql/service"
 	"github.com/eveisesi/neo/services/alliance"
+	"github.com/eveisesi/neo/services/battle"
 	"github.com/eveisesi/neo/services/character"
 	"github.com/eveisesi/neo/services/corporation"
 	"github.com/eveisesi/neo/services/killmail"
+	"github.com/eveisesi/neo/services/notifications"
 	"github.com/eveisesi/neo/services/search"
+	"github.com/eveisesi/neo/services/stats"
 	"github.com/eveisesi/neo/services/token"
 	"github.com/eveisesi/neo/services/universe"
+	"github.com/eveisesi/neo/services/watchlist"
 	"github.com/go-chi/chi"
 	"github.com/go-chi/chi/middleware"
 	"github.com/sirupsen/logrus"
@@ -44,13 +48,20 @@ type Server struct {
 	logger *logrus.Logger
 	redis  *redis.Client
 
-	alliance    alliance.Service
-	token       token.Service
-	character   character.Service
-	corporation corporation.Service
-	killmail    killmail.Service
-	search      search.Service
-	universe    universe.Service
+	// Slash commands are only served when a signing secret is configured
+	slackSigningSecret string
+
+	alliance     alliance.Service
+	battle       battle.Service
+	token        token.Service
+	character    character.Service
+	corporation  corporation.Service
+	killmail     killmail.Service
+	notification notifications.Service
+	search       search.Service
+	stats        stats.Service
+	universe     universe.Service
+	watchlist    watchlist.Service
 }
 
 func Action(c *cli.Context) {
@@ -60,13 +71,18 @@ func Action(c *cli.Context) {
 		app.Config.ServerPort,
 		app.Logger,
 		app.Redis,
+		app.Config.SlackSigningSecret,
 		app.Alliance,
+		app.Battle,
 		app.Character,
 		app.Corporation,
 		app.Killmail,
+		app.Notification,
 		app.Search,
+		app.Stats,
 		app.Token,
 		app.Universe,
+		app.Watchlist,
 	)
 	app.Logger.WithField("port", app.Config.ServerPort).Info("attempting to start server...")
 	go cleanUpVisitors()
@@ -98,13 +114,18 @@ func NewServer(
 	port uint,
 	logger *logrus.Logger,
 	redis *redis.Client,
+	slackSigningSecret string,
 	alliance alliance.Service,
+	battle battle.Service,
 	character character.Service,
 	corporation corporation.Service,
 	killmail killmail.Service,
+	notification notifications.Service,
 	search search.Service,
+	stats stats.Service,
 	token token.Service,
 	universe universe.Service,
+	watchlist watchlist.Service,
 ) *Server {
 
 	visitors = make(map[string]*visitor)
@@ -113,13 +134,19 @@ func NewServer(
 		logger: logger,
 		redis:  redis,
 
-		alliance:    alliance,
-		character:   character,
-		corporation: corporation,
-		killmail:    killmail,
-		search:      search,
-		token:       token,
-		universe:    universe,
+		slackSigningSecret: slackSigningSecret,
+
+		alliance:     alliance,
+		battle:       battle,
+		character:    character,
+		corporation:  corporation,
+		killmail:     killmail,
+		notification: notification,
+		search:       search,
+		stats:        stats,
+		token:        token,
+		universe:     universe,
+		watchlist:    watchlist,
 	}
 
 	w := logger.Writer()
@@ -144,16 +171,21 @@ func (s *Server) RegisterRoutes() *chi.Mux {
 
 	r.Group(func(r chi.Router) {
 		r.Use(s.Dataloaders)
+		r.Use(s.Authenticate)
 		r.Use(NewStructuredLogger(s.logger))
 
 		schema := service.NewExecutableSchema(service.Config{
-			Resolvers: resolvers.NewResolver(CtxLoaders, s.logger, s.redis, resolvers.Services{
+			Resolvers: resolvers.NewResolver(CtxLoaders, CtxViewer, s.logger, s.redis, resolvers.Services{
 				Killmail:    s.killmail,
 				Alliance:    s.alliance,
 				Corporation: s.corporation,
 				Character:   s.character,
 				Universe:    s.universe,
 				Search:      s.search,
+				Stats:       s.stats,
+				Battle:      s.battle,
+				Token:       s.token,
+				Watchlist:   s.watchlist,
 			}),
 		})
 
@@ -218,10 +250,19 @@ func (s *Server) RegisterRoutes() *chi.Mux {
 
 	r.Group(func(r chi.Router) {
 		r.Use(s.RateLimiter)
+		r.Use(s.AuthenticateOptional)
 		r.Get("/auth/state", s.handleGetState)
 		r.Post("/auth/token", s.handlePostCode)
 	})
 
+	if s.slackSigningSecret != "" {
+		r.Group(func(r chi.Router) {
+			r.Use(s.VerifySlack)
+			r.Post("/slack/commands", s.handleSlackCommand)
+			r.Post("/slack/interactions", s.handleSlackInteraction)
+		})
+	}
+
 	return r
 
 }
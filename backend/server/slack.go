@@ -0,0 +1,64 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+	goslack "github.com/slack-go/slack"
+)
+
+// maxSlackBody caps the size of the requests Slack sends us
+const maxSlackBody = 1 << 16
+
+var errInvalidSlackSignature = errors.New("invalid slack signature")
+
+// VerifySlack rejects requests that were not signed with the Slack signing secret. The body is read to verify the
+// signature and replaced, so handlers can read it as usual
+func (s *Server) VerifySlack(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		verifier, err := goslack.NewSecretsVerifier(r.Header, s.slackSigningSecret)
+		if err != nil {
+			s.WriteError(w, http.StatusUnauthorized, errInvalidSlackSignature)
+			return
+		}
+
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxSlackBody))
+		if err != nil {
+			s.WriteError(w, http.StatusBadRequest, errors.New("failed to read request body"))
+			return
+		}
+
+		_, _ = verifier.Write(body)
+		if verifier.Ensure() != nil {
+			s.WriteError(w, http.StatusUnauthorized, errInvalidSlackSignature)
+			return
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleSlackCommand answers /neo slash commands. Slack shows the response to the user that issued the command,
+// or to the whole channel for in_channel responses
+func (s *Server) handleSlackCommand(w http.ResponseWriter, r *http.Request) {
+
+	command, err := goslack.SlashCommandParse(r)
+	if err != nil {
+		s.WriteError(w, http.StatusBadRequest, errors.New("failed to parse slash command"))
+		return
+	}
+
+	s.WriteSuccess(w, http.StatusOK, s.notification.SlashCommand(r.Context(), command.Text))
+
+}
+
+// handleSlackInteraction acknowledges interactions with the messages we post. The buttons on those messages only
+// link to the site, but Slack still reports every click here and flags the message when it is not acknowledged
+func (s *Server) handleSlackInteraction(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,560 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	// Manifest describes the contents of a daily archive. It is written alongside the archive so that
+	// the archive can be verified without being decompressed
+	Manifest struct {
+		Date     string        `json:"date"`
+		Count    int           `json:"count"`
+		Checksum string        `json:"checksum"`
+		Entries  []*IndexEntry `json:"entries"`
+	}
+
+	// IndexEntry locates a single killmail within the decompressed archive
+	IndexEntry struct {
+		ID       uint   `json:"id"`
+		Hash     string `json:"hash"`
+		Offset   int64  `json:"offset"`
+		Length   int64  `json:"length"`
+		Checksum string `json:"checksum"`
+	}
+
+	// Verification is the result of verifying the archive for a single day
+	Verification struct {
+		Date     time.Time
+		Archived int
+		Expected int
+		Problems []string
+	}
+)
+
+// OK reports whether the archive for the day is intact and holds every known killmail hash for the day
+func (v *Verification) OK() bool {
+	return len(v.Problems) == 0 && v.Archived == v.Expected
+}
+
+// Archive rolls the staging file and any legacy per killmail files for the day into a single zstd compressed
+// NDJSON archive and writes its manifest. If an archive already exists for the day, its records are carried over.
+// Staging and legacy files are only removed once the new archive and manifest have been written
+func (s *service) Archive(ctx context.Context, dir string, date time.Time) (*Manifest, error) {
+
+	day := date.Format("2006-01-02")
+	entry := s.logger.WithContext(ctx).WithField("date", day)
+
+	staging := fmt.Sprintf(neo.BACKUP_KILLMAIL_STAGING_FORMAT, dir, day)
+
+	// Move the staging file aside so that killmails backed up while we archive land in a fresh staging file.
+	// Files sealed by an earlier run that failed part way through are picked up alongside it
+	err := sealStaging(staging, fmt.Sprintf("%s.%d.archiving", staging, time.Now().UnixNano()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to seal staging file")
+	}
+
+	sealed, err := filepath.Glob(staging + ".*.archiving")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sealed staging files")
+	}
+
+	records := make(map[uint]*record)
+	collect := func(id uint, hash string, data []byte) error {
+		records[id] = &record{ID: id, Hash: hash, Killmail: data}
+		return nil
+	}
+
+	err = s.readArchive(dir, day, collect)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.readLegacy(dir, day, collect)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range sealed {
+		err = s.readStaging(path, collect)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(records) == 0 {
+		entry.Info("nothing to archive")
+		return nil, nil
+	}
+
+	ids := make([]uint, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	archive := fmt.Sprintf(neo.BACKUP_KILLMAIL_ARCHIVE_FORMAT, dir, day)
+	manifest, err := s.writeArchive(archive, day, ids, records)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeManifest(fmt.Sprintf(neo.BACKUP_KILLMAIL_INDEX_FORMAT, dir, day), manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range sealed {
+		err = os.Remove(path)
+		if err != nil && !os.IsNotExist(err) {
+			entry.WithError(err).WithField("file", path).Error("failed to remove sealed staging file")
+		}
+	}
+
+	err = os.RemoveAll(fmt.Sprintf(neo.BACKUP_KILLMAIL_RAW_PARENT_DIRECTORY_FORMAT, dir, day))
+	if err != nil {
+		entry.WithError(err).Error("failed to remove legacy backup directory")
+	}
+
+	entry.WithField("count", manifest.Count).Info("archive written")
+
+	return manifest, nil
+
+}
+
+// StagedDates returns the days that have a staging file, or a sealed staging file left behind by a failed archive,
+// waiting to be archived, oldest first
+func (s *service) StagedDates(ctx context.Context, dir string) ([]time.Time, error) {
+
+	paths, err := filepath.Glob(fmt.Sprintf(neo.BACKUP_KILLMAIL_STAGING_FORMAT, dir, "*"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list staging files")
+	}
+
+	sealed, err := filepath.Glob(fmt.Sprintf(neo.BACKUP_KILLMAIL_STAGING_FORMAT, dir, "*") + ".*.archiving")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list sealed staging files")
+	}
+
+	seen := make(map[string]bool)
+	dates := make([]time.Time, 0)
+	for _, path := range append(paths, sealed...) {
+		day := strings.SplitN(filepath.Base(path), ".", 2)[0]
+		if seen[day] {
+			continue
+		}
+		seen[day] = true
+
+		date, err := time.Parse("2006-01-02", day)
+		if err != nil {
+			s.logger.WithError(err).WithField("file", path).Error("skipping unrecognised staging file")
+			continue
+		}
+
+		dates = append(dates, date)
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+
+	return dates, nil
+
+}
+
+// Read calls fn for every killmail backed up for the day, whether it sits in the archive, the staging file
+// or the legacy per killmail layout
+func (s *service) Read(ctx context.Context, dir string, date time.Time, fn func(id uint, hash string, data []byte) error) error {
+
+	day := date.Format("2006-01-02")
+
+	err := s.readArchive(dir, day, fn)
+	if err != nil {
+		return err
+	}
+
+	err = s.readLegacy(dir, day, fn)
+	if err != nil {
+		return err
+	}
+
+	staging := fmt.Sprintf(neo.BACKUP_KILLMAIL_STAGING_FORMAT, dir, day)
+	sealed, err := filepath.Glob(staging + ".*.archiving")
+	if err != nil {
+		return errors.Wrap(err, "failed to list sealed staging files")
+	}
+
+	for _, path := range append(sealed, staging) {
+		err = s.readStaging(path, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+// Verify checks the archive of each day between from and to inclusive against its manifest and compares the
+// number of archived killmails against the number of kill hashes stored for the day
+func (s *service) Verify(ctx context.Context, dir string, from, to time.Time) ([]*Verification, error) {
+
+	if to.Before(from) {
+		return nil, errors.New("to must not be before from")
+	}
+
+	verifications := make([]*Verification, 0)
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+
+		hashes, err := s.killmails.KillHashesByDate(ctx, date)
+		if err != nil {
+			return verifications, errors.Wrapf(err, "failed to fetch kill hashes for %s", date.Format("2006-01-02"))
+		}
+
+		verification := s.verifyDay(dir, date)
+		verification.Expected = len(hashes)
+
+		s.logger.WithContext(ctx).WithFields(logrus.Fields{
+			"date":     date.Format("2006-01-02"),
+			"archived": verification.Archived,
+			"expected": verification.Expected,
+		}).Debug("verified archive")
+
+		verifications = append(verifications, verification)
+	}
+
+	return verifications, nil
+
+}
+
+func (s *service) verifyDay(dir string, date time.Time) *Verification {
+
+	day := date.Format("2006-01-02")
+	verification := &Verification{Date: date}
+
+	manifest, err := readManifest(fmt.Sprintf(neo.BACKUP_KILLMAIL_INDEX_FORMAT, dir, day))
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			verification.Problems = append(verification.Problems, "archive manifest is missing")
+			return verification
+		}
+		verification.Problems = append(verification.Problems, err.Error())
+		return verification
+	}
+
+	verification.Archived = manifest.Count
+
+	archive := fmt.Sprintf(neo.BACKUP_KILLMAIL_ARCHIVE_FORMAT, dir, day)
+	checksum, err := fileChecksum(archive)
+	if err != nil {
+		verification.Problems = append(verification.Problems, err.Error())
+		return verification
+	}
+
+	if checksum != manifest.Checksum {
+		verification.Problems = append(verification.Problems, "archive checksum does not match manifest")
+		return verification
+	}
+
+	entries := make(map[uint]*IndexEntry, len(manifest.Entries))
+	for _, entry := range manifest.Entries {
+		entries[entry.ID] = entry
+	}
+
+	count := 0
+	err = s.readArchive(dir, day, func(id uint, hash string, data []byte) error {
+		count++
+		entry, ok := entries[id]
+		if !ok {
+			verification.Problems = append(verification.Problems, fmt.Sprintf("killmail %d is not in the manifest", id))
+			return nil
+		}
+		if entry.Hash != hash || entry.Checksum != checksumOf(data) {
+			verification.Problems = append(verification.Problems, fmt.Sprintf("killmail %d does not match the manifest", id))
+		}
+		return nil
+	})
+	if err != nil {
+		verification.Problems = append(verification.Problems, err.Error())
+		return verification
+	}
+
+	if count != manifest.Count {
+		verification.Problems = append(verification.Problems, fmt.Sprintf("archive holds %d killmails, manifest records %d", count, manifest.Count))
+	}
+
+	return verification
+
+}
+
+func (s *service) writeArchive(path, day string, ids []uint, records map[uint]*record) (*Manifest, error) {
+
+	tmp := path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create archive")
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	encoder, err := zstd.NewWriter(io.MultiWriter(file, hasher))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize archive encoder")
+	}
+
+	manifest := &Manifest{
+		Date:    day,
+		Entries: make([]*IndexEntry, 0, len(ids)),
+	}
+
+	offset := int64(0)
+	for _, id := range ids {
+		record := records[id]
+
+		// Legacy files may contain insignificant whitespace, which json.Marshal would strip from the
+		// archived record. Compacting up front keeps the checksum stable across a round trip
+		var compact bytes.Buffer
+		err := json.Compact(&compact, record.Killmail)
+		if err != nil {
+			encoder.Close()
+			return nil, errors.Wrapf(err, "killmail %d is not valid json", id)
+		}
+		record.Killmail = compact.Bytes()
+
+		line, err := json.Marshal(record)
+		if err != nil {
+			encoder.Close()
+			return nil, errors.Wrapf(err, "failed to marshal killmail %d for archive", id)
+		}
+		line = append(line, '\n')
+
+		_, err = encoder.Write(line)
+		if err != nil {
+			encoder.Close()
+			return nil, errors.Wrap(err, "failed to write to archive")
+		}
+
+		manifest.Entries = append(manifest.Entries, &IndexEntry{
+			ID:       record.ID,
+			Hash:     record.Hash,
+			Offset:   offset,
+			Length:   int64(len(line)),
+			Checksum: checksumOf(record.Killmail),
+		})
+		offset += int64(len(line))
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to flush archive")
+	}
+
+	err = file.Sync()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sync archive")
+	}
+
+	err = os.Rename(tmp, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to move archive into place")
+	}
+
+	manifest.Count = len(manifest.Entries)
+	manifest.Checksum = hex.EncodeToString(hasher.Sum(nil))
+
+	return manifest, nil
+
+}
+
+func (s *service) readArchive(dir, day string, fn func(id uint, hash string, data []byte) error) error {
+
+	path := fmt.Sprintf(neo.BACKUP_KILLMAIL_ARCHIVE_FORMAT, dir, day)
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to open archive")
+	}
+	defer file.Close()
+
+	decoder, err := zstd.NewReader(file)
+	if err != nil {
+		return errors.Wrap(err, "failed to initialize archive decoder")
+	}
+	defer decoder.Close()
+
+	return readRecords(decoder, fn)
+
+}
+
+func (s *service) readStaging(path string, fn func(id uint, hash string, data []byte) error) error {
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to open staging file")
+	}
+	defer file.Close()
+
+	return readRecords(file, fn)
+
+}
+
+// readLegacy reads the one file per killmail layout that predates daily archives
+func (s *service) readLegacy(dir, day string, fn func(id uint, hash string, data []byte) error) error {
+
+	directory := fmt.Sprintf(neo.BACKUP_KILLMAIL_RAW_PARENT_DIRECTORY_FORMAT, dir, day)
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to read backup directory %s", directory)
+	}
+
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+
+		id, hash, err := parseLegacyName(file.Name())
+		if err != nil {
+			s.logger.WithError(err).WithField("file", file.Name()).Error("skipping unrecognised backup file")
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(directory, file.Name()))
+		if err != nil {
+			return errors.Wrapf(err, "failed to read backup file %s", file.Name())
+		}
+
+		err = fn(id, hash, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+
+}
+
+func readRecords(r io.Reader, fn func(id uint, hash string, data []byte) error) error {
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var record = record{}
+			uerr := json.Unmarshal(line, &record)
+			if uerr != nil {
+				return errors.Wrap(uerr, "failed to unmarshal backup record")
+			}
+
+			ferr := fn(record.ID, record.Hash, record.Killmail)
+			if ferr != nil {
+				return ferr
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to read backup records")
+		}
+	}
+
+}
+
+// parseLegacyName extracts the killmail id and hash from a file named according to BACKUP_KILLMAIL_RAW_NAME_FORMAT
+func parseLegacyName(name string) (uint, string, error) {
+
+	parts := strings.SplitN(strings.TrimSuffix(name, ".json"), "-", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", errors.Errorf("unexpected backup file name %s", name)
+	}
+
+	id, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "unexpected backup file name %s", name)
+	}
+
+	return uint(id), parts[1], nil
+
+}
+
+func writeManifest(path string, manifest *Manifest) error {
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal manifest")
+	}
+
+	err = ioutil.WriteFile(path+".tmp", data, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to write manifest")
+	}
+
+	return errors.Wrap(os.Rename(path+".tmp", path), "failed to move manifest into place")
+
+}
+
+func readManifest(path string) (*Manifest, error) {
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read manifest")
+	}
+
+	var manifest = new(Manifest)
+	err = json.Unmarshal(data, manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal manifest")
+	}
+
+	return manifest, nil
+
+}
+
+func fileChecksum(path string) (string, error) {
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to open archive")
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	_, err = io.Copy(hasher, file)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to checksum archive")
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+
+}
+
+func checksumOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
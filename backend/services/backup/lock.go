@@ -0,0 +1,68 @@
+package backup
+
+import (
+	"os"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// Staging files are shared between processes: the importers append to them while the archiver cron seals them.
+// Both sides take an exclusive flock on the staging file, so a staging file is never renamed away while a write
+// to it is in progress and a writer never appends to a file that has already been sealed
+
+// openStaging opens the staging file at path for appending, holding an exclusive lock on it. If the file is sealed
+// while we wait on the lock, the fresh staging file at path is opened instead. Closing the file releases the lock
+func openStaging(path string) (*os.File, error) {
+
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open staging file")
+		}
+
+		err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+		if err != nil {
+			file.Close()
+			return nil, errors.Wrap(err, "failed to lock staging file")
+		}
+
+		held, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return nil, errors.Wrap(err, "failed to stat staging file")
+		}
+
+		current, err := os.Stat(path)
+		if err == nil && os.SameFile(held, current) {
+			return file, nil
+		}
+
+		file.Close()
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, "failed to stat staging file")
+		}
+	}
+
+}
+
+// sealStaging renames the staging file at path to sealed once every in flight write to it has finished
+func sealStaging(path, sealed string) error {
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to open staging file")
+	}
+	defer file.Close()
+
+	err = syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		return errors.Wrap(err, "failed to lock staging file")
+	}
+
+	return os.Rename(path, sealed)
+
+}
@@ -2,8 +2,8 @@ package backup
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/eveisesi/neo"
@@ -13,40 +13,56 @@ import (
 
 type Service interface {
 	BackupKillmail(ctx context.Context, date time.Time, payload neo.Message, data []byte)
+	Archive(ctx context.Context, dir string, date time.Time) (*Manifest, error)
+	StagedDates(ctx context.Context, dir string) ([]time.Time, error)
+	Read(ctx context.Context, dir string, date time.Time, fn func(id uint, hash string, data []byte) error) error
+	Verify(ctx context.Context, dir string, from, to time.Time) ([]*Verification, error)
 }
 
 type service struct {
-	redis  *redis.Client
-	logger *logrus.Logger
+	redis     *redis.Client
+	logger    *logrus.Logger
+	killmails neo.KillmailRepository
 }
 
-func NewService(redis *redis.Client, logger *logrus.Logger) Service {
+// record is a single line of a staging file or archive
+type record struct {
+	ID       uint            `json:"id"`
+	Hash     string          `json:"hash"`
+	Killmail json.RawMessage `json:"killmail"`
+}
+
+func NewService(redis *redis.Client, logger *logrus.Logger, killmails neo.KillmailRepository) Service {
 	return &service{
-		redis:  redis,
-		logger: logger,
+		redis:     redis,
+		logger:    logger,
+		killmails: killmails,
 	}
 }
 
+// BackupKillmail appends the raw ESI payload for a killmail to the staging file for the day it occurred on.
+// Staging files are rolled up into a compressed archive by Archive
 func (s *service) BackupKillmail(ctx context.Context, date time.Time, payload neo.Message, data []byte) {
 
 	entry := s.logger.WithContext(ctx).WithField("id", payload.ID).WithField("hash", payload.Hash)
 
-	directory := fmt.Sprintf(neo.BACKUP_KILLMAIL_RAW_PARENT_DIRECTORY_FORMAT, date.Format("2006-01-02"))
-	if _, err := os.Stat(directory); os.IsNotExist(err) {
-		_ = os.Mkdir(directory, 0555)
+	line, err := json.Marshal(record{ID: payload.ID, Hash: payload.Hash, Killmail: data})
+	if err != nil {
+		entry.WithError(err).Error("failed to marshal killmail for backup")
+		return
 	}
 
-	file, err := os.OpenFile(fmt.Sprintf(neo.BACKUP_KILLMAIL_RAW_NAME_FORMAT, directory, payload.ID, payload.Hash), os.O_CREATE|os.O_WRONLY, 0444)
+	path := fmt.Sprintf(neo.BACKUP_KILLMAIL_STAGING_FORMAT, neo.BACKUP_KILLMAIL_RAW_DIRECTORY, date.Format("2006-01-02"))
+	file, err := openStaging(path)
 	if err != nil {
-		entry.WithError(err).Error("failed to open backup file for killmail")
+		entry.WithError(err).Error("failed to open backup staging file")
+		return
 	}
+	defer file.Close()
 
-	if err == nil && data != nil {
-		_, err = file.WriteString(string(data))
-		if err != nil {
-			entry.WithError(err).Error("failed to write killmail to backup file")
-		}
+	_, err = file.Write(append(line, '\n'))
+	if err != nil {
+		entry.WithError(err).Error("failed to write killmail to backup staging file")
 	}
 
-	file.Close()
 }
@@ -0,0 +1,291 @@
+package battle
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+)
+
+// Filter narrows down the killmails that battle reports are detected from. From and To are required.
+// Zero values for the remaining fields fall back to their defaults or are ignored
+type Filter struct {
+	From            time.Time
+	To              time.Time
+	Scope           neo.BattleScope
+	SolarSystemID   uint
+	ConstellationID uint
+	RegionID        uint
+	// Window is the longest gap allowed between two consecutive killmails of the same battle
+	Window time.Duration
+	// MinKillmails is the smallest number of killmails a cluster needs before it is reported as a battle
+	MinKillmails int
+}
+
+const (
+	defaultWindow       = time.Minute * 15
+	maxWindow           = time.Hour * 2
+	defaultMinKillmails = 10
+	// maxRange is the longest period that can be scanned for battles in a single request
+	maxRange = time.Hour * 48
+	// maxKillmails caps the number of killmails loaded to detect battles. Requests exceeding it need to be narrowed
+	maxKillmails = 20000
+)
+
+func (f *Filter) validate() error {
+
+	if f.From.IsZero() || f.To.IsZero() {
+		return errors.New("from and to are required")
+	}
+
+	if !f.To.After(f.From) {
+		return errors.New("to must be after from")
+	}
+
+	if f.To.Sub(f.From) > maxRange {
+		return errors.Errorf("range between from and to must not exceed %s", maxRange)
+	}
+
+	if f.Scope == "" {
+		f.Scope = neo.BattleScopeSystem
+	}
+
+	if !f.Scope.IsValid() {
+		return errors.Errorf("%s is not a valid battle scope", f.Scope)
+	}
+
+	if f.Window <= 0 {
+		f.Window = defaultWindow
+	}
+
+	if f.Window > maxWindow {
+		return errors.Errorf("window must not exceed %s", maxWindow)
+	}
+
+	if f.MinKillmails <= 0 {
+		f.MinKillmails = defaultMinKillmails
+	}
+
+	return nil
+
+}
+
+func (f Filter) operators() []*neo.Operator {
+
+	operators := []*neo.Operator{
+		neo.NewGreaterThanEqualToOperator("killmailTime", f.From),
+		neo.NewLessThanOperator("killmailTime", f.To),
+		neo.NewEqualOperator("isNPC", false),
+	}
+
+	if f.SolarSystemID > 0 {
+		operators = append(operators, neo.NewEqualOperator("solarSystemID", f.SolarSystemID))
+	}
+
+	if f.ConstellationID > 0 {
+		operators = append(operators, neo.NewEqualOperator("constellationID", f.ConstellationID))
+	}
+
+	if f.RegionID > 0 {
+		operators = append(operators, neo.NewEqualOperator("regionID", f.RegionID))
+	}
+
+	return operators
+
+}
+
+// BattleReports detects every battle in the range of the filter, newest first
+func (s *service) BattleReports(ctx context.Context, filter Filter) ([]*neo.BattleReport, error) {
+
+	err := filter.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	killmails, err := s.fetchKillmails(ctx, filter.operators())
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*neo.BattleReport, 0)
+	for location, group := range groupByLocation(filter.Scope, killmails) {
+		for _, cluster := range clusterByWindow(group, filter.Window) {
+			if len(cluster) < filter.MinKillmails {
+				continue
+			}
+
+			reports = append(reports, buildReport(filter.Scope, location, cluster))
+		}
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].StartTime.Equal(reports[j].StartTime) {
+			return reports[i].LocationID < reports[j].LocationID
+		}
+		return reports[i].StartTime.After(reports[j].StartTime)
+	})
+
+	return reports, nil
+
+}
+
+// BattleReport rebuilds a single battle report from its id. The id encodes the scope, location and time span of
+// the battle, so the report is rebuilt from the killmails in that span
+func (s *service) BattleReport(ctx context.Context, id string) (*neo.BattleReport, error) {
+
+	scope, location, start, end, err := decodeID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	field := "solarSystemID"
+	if scope == neo.BattleScopeConstellation {
+		field = "constellationID"
+	}
+
+	killmails, err := s.fetchKillmails(ctx, []*neo.Operator{
+		neo.NewGreaterThanEqualToOperator("killmailTime", start),
+		neo.NewLessThanEqualToOperator("killmailTime", end),
+		neo.NewEqualOperator("isNPC", false),
+		neo.NewEqualOperator(field, location),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(killmails) == 0 {
+		return nil, errors.New("no killmails found for battle report")
+	}
+
+	return buildReport(scope, location, killmails), nil
+
+}
+
+func (s *service) fetchKillmails(ctx context.Context, operators []*neo.Operator) ([]*neo.Killmail, error) {
+
+	operators = append(
+		operators,
+		neo.NewOrderOperator("killmailTime", neo.SortAsc),
+		neo.NewLimitOperator(maxKillmails+1),
+	)
+
+	killmails, err := s.killmails.Killmails(ctx, operators...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch killmails for battle detection")
+	}
+
+	if len(killmails) > maxKillmails {
+		return nil, errors.Errorf("more than %d killmails matched, narrow down the range or location", maxKillmails)
+	}
+
+	return killmails, nil
+
+}
+
+// groupByLocation splits time ordered killmails by the solar system or constellation they occurred in.
+// The order of the killmails is kept within each group
+func groupByLocation(scope neo.BattleScope, killmails []*neo.Killmail) map[uint][]*neo.Killmail {
+
+	groups := make(map[uint][]*neo.Killmail)
+	for _, killmail := range killmails {
+		location := killmail.SolarSystemID
+		if scope == neo.BattleScopeConstellation {
+			location = killmail.ConstellationID
+		}
+
+		groups[location] = append(groups[location], killmail)
+	}
+
+	return groups
+
+}
+
+// clusterByWindow splits time ordered killmails wherever the gap between two consecutive killmails exceeds window
+func clusterByWindow(killmails []*neo.Killmail, window time.Duration) [][]*neo.Killmail {
+
+	clusters := make([][]*neo.Killmail, 0)
+	if len(killmails) == 0 {
+		return clusters
+	}
+
+	current := []*neo.Killmail{killmails[0]}
+	for _, killmail := range killmails[1:] {
+		if killmail.KillmailTime.Sub(current[len(current)-1].KillmailTime) > window {
+			clusters = append(clusters, current)
+			current = make([]*neo.Killmail, 0)
+		}
+
+		current = append(current, killmail)
+	}
+
+	return append(clusters, current)
+
+}
+
+func buildReport(scope neo.BattleScope, location uint, killmails []*neo.Killmail) *neo.BattleReport {
+
+	report := &neo.BattleReport{
+		Scope:      scope,
+		LocationID: location,
+		StartTime:  killmails[0].KillmailTime,
+		EndTime:    killmails[len(killmails)-1].KillmailTime,
+		Killmails:  killmails,
+		Sides:      buildSides(killmails),
+	}
+
+	for _, killmail := range killmails {
+		report.TotalValue += killmail.TotalValue
+	}
+
+	report.ID = encodeID(scope, location, report.StartTime, report.EndTime)
+
+	return report
+
+}
+
+const idFormat = "%s:%d:%d:%d"
+
+func encodeID(scope neo.BattleScope, location uint, start, end time.Time) string {
+	raw := fmt.Sprintf(idFormat, scope, location, start.Unix(), end.Unix())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeID(id string) (neo.BattleScope, uint, time.Time, time.Time, error) {
+
+	invalid := errors.New("invalid battle report id")
+
+	raw, err := base64.RawURLEncoding.DecodeString(id)
+	if err != nil {
+		return "", 0, time.Time{}, time.Time{}, invalid
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 4 {
+		return "", 0, time.Time{}, time.Time{}, invalid
+	}
+
+	scope := neo.BattleScope(parts[0])
+	location, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil || !scope.IsValid() {
+		return "", 0, time.Time{}, time.Time{}, invalid
+	}
+
+	start, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", 0, time.Time{}, time.Time{}, invalid
+	}
+
+	end, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil || end < start {
+		return "", 0, time.Time{}, time.Time{}, invalid
+	}
+
+	return scope, uint(location), time.Unix(start, 0).UTC(), time.Unix(end, 0).UTC(), nil
+
+}
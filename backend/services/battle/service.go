@@ -0,0 +1,28 @@
+package battle
+
+import (
+	"context"
+
+	"github.com/eveisesi/neo"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/sirupsen/logrus"
+)
+
+type Service interface {
+	BattleReports(ctx context.Context, filter Filter) ([]*neo.BattleReport, error)
+	BattleReport(ctx context.Context, id string) (*neo.BattleReport, error)
+}
+
+type service struct {
+	logger    *logrus.Logger
+	newrelic  *newrelic.Application
+	killmails neo.KillmailRepository
+}
+
+func NewService(logger *logrus.Logger, newrelic *newrelic.Application, killmails neo.KillmailRepository) Service {
+	return &service{
+		logger,
+		newrelic,
+		killmails,
+	}
+}
@@ -0,0 +1,243 @@
+package battle
+
+import (
+	"sort"
+
+	"github.com/eveisesi/neo"
+)
+
+// party is the unit sides are built from. Characters are grouped by their alliance, falling back to their
+// corporation when they are not in an alliance
+type party struct {
+	alliance bool
+	id       uint
+}
+
+func partyOf(allianceID, corporationID *uint) (party, bool) {
+
+	if allianceID != nil && *allianceID > 0 {
+		return party{alliance: true, id: *allianceID}, true
+	}
+
+	if corporationID != nil && *corporationID > 0 {
+		return party{id: *corporationID}, true
+	}
+
+	return party{}, false
+
+}
+
+// buildSides infers the sides of a battle from the killmails that make it up. Parties that shoot at the same
+// victims are pulled onto the same side while parties that shoot at each other are pushed apart. Parties are
+// placed from the most to the least involved, joining the side they have the strongest positive affinity with or
+// starting a new side when they have none
+func buildSides(killmails []*neo.Killmail) []*neo.BattleSide {
+
+	var (
+		friendly    = make(map[party]map[party]int)
+		hostile     = make(map[party]map[party]int)
+		involvement = make(map[party]int)
+	)
+
+	increment := func(m map[party]map[party]int, a, b party) {
+		if a == b {
+			return
+		}
+		if _, ok := m[a]; !ok {
+			m[a] = make(map[party]int)
+		}
+		if _, ok := m[b]; !ok {
+			m[b] = make(map[party]int)
+		}
+		m[a][b]++
+		m[b][a]++
+	}
+
+	for _, killmail := range killmails {
+		victim, ok := victimParty(killmail)
+		if !ok {
+			continue
+		}
+
+		involvement[victim]++
+
+		attackers := attackerParties(killmail)
+		for i, attacker := range attackers {
+			involvement[attacker]++
+			increment(hostile, attacker, victim)
+			for _, other := range attackers[i+1:] {
+				increment(friendly, attacker, other)
+			}
+		}
+	}
+
+	parties := make([]party, 0, len(involvement))
+	for p := range involvement {
+		parties = append(parties, p)
+	}
+
+	sort.Slice(parties, func(i, j int) bool {
+		a, b := parties[i], parties[j]
+		if involvement[a] != involvement[b] {
+			return involvement[a] > involvement[b]
+		}
+		if a.alliance != b.alliance {
+			return a.alliance
+		}
+		return a.id < b.id
+	})
+
+	groups := make([][]party, 0)
+	membership := make(map[party]int, len(parties))
+	for _, p := range parties {
+		best, bestScore := -1, 0
+		for i, group := range groups {
+			score := 0
+			for _, member := range group {
+				score += friendly[p][member] - hostile[p][member]
+			}
+
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+
+		if best < 0 {
+			groups = append(groups, make([]party, 0))
+			best = len(groups) - 1
+		}
+
+		groups[best] = append(groups[best], p)
+		membership[p] = best
+	}
+
+	return summarizeSides(killmails, groups, membership)
+
+}
+
+func summarizeSides(killmails []*neo.Killmail, groups [][]party, membership map[party]int) []*neo.BattleSide {
+
+	var (
+		sides        = make([]*neo.BattleSide, len(groups))
+		corporations = make([]map[uint]bool, len(groups))
+		characters   = make([]map[uint64]bool, len(groups))
+	)
+
+	for i, group := range groups {
+		sides[i] = &neo.BattleSide{
+			AllianceIDs:    make([]uint, 0),
+			CorporationIDs: make([]uint, 0),
+			CharacterIDs:   make([]uint64, 0),
+		}
+		corporations[i] = make(map[uint]bool)
+		characters[i] = make(map[uint64]bool)
+
+		for _, p := range group {
+			if p.alliance {
+				sides[i].AllianceIDs = append(sides[i].AllianceIDs, p.id)
+			}
+		}
+	}
+
+	record := func(side int, corporationID *uint, characterID *uint64) {
+		if corporationID != nil && *corporationID > 0 {
+			corporations[side][*corporationID] = true
+		}
+		if characterID != nil && *characterID > 0 {
+			characters[side][*characterID] = true
+		}
+	}
+
+	for _, killmail := range killmails {
+		victim, ok := victimParty(killmail)
+		if !ok {
+			continue
+		}
+
+		victimSide := membership[victim]
+		sides[victimSide].ISKLost += killmail.TotalValue
+		sides[victimSide].ShipsLost++
+		record(victimSide, killmail.Victim.CorporationID, killmail.Victim.CharacterID)
+
+		credited := make(map[int]bool)
+		for _, attacker := range killmail.Attackers {
+			if attacker.CharacterID == nil {
+				continue
+			}
+
+			p, ok := partyOf(attacker.AllianceID, attacker.CorporationID)
+			if !ok {
+				continue
+			}
+
+			side := membership[p]
+			record(side, attacker.CorporationID, attacker.CharacterID)
+
+			// Killmails are only credited once per side, and never to the side that took the loss
+			if side == victimSide || credited[side] {
+				continue
+			}
+
+			credited[side] = true
+			sides[side].ISKKilled += killmail.TotalValue
+			sides[side].ShipsKilled++
+		}
+	}
+
+	for i, side := range sides {
+		for id := range corporations[i] {
+			side.CorporationIDs = append(side.CorporationIDs, id)
+		}
+		for id := range characters[i] {
+			side.CharacterIDs = append(side.CharacterIDs, id)
+		}
+
+		sort.Slice(side.AllianceIDs, func(a, b int) bool { return side.AllianceIDs[a] < side.AllianceIDs[b] })
+		sort.Slice(side.CorporationIDs, func(a, b int) bool { return side.CorporationIDs[a] < side.CorporationIDs[b] })
+		sort.Slice(side.CharacterIDs, func(a, b int) bool { return side.CharacterIDs[a] < side.CharacterIDs[b] })
+
+		if side.ISKKilled+side.ISKLost > 0 {
+			side.Efficiency = side.ISKKilled / (side.ISKKilled + side.ISKLost)
+		}
+	}
+
+	sort.SliceStable(sides, func(i, j int) bool {
+		return len(sides[i].CharacterIDs) > len(sides[j].CharacterIDs)
+	})
+
+	return sides
+
+}
+
+func victimParty(killmail *neo.Killmail) (party, bool) {
+
+	if killmail.Victim == nil {
+		return party{}, false
+	}
+
+	return partyOf(killmail.Victim.AllianceID, killmail.Victim.CorporationID)
+
+}
+
+// attackerParties returns the distinct parties of the player attackers on a killmail. NPC attackers are ignored
+func attackerParties(killmail *neo.Killmail) []party {
+
+	seen := make(map[party]bool)
+	parties := make([]party, 0)
+	for _, attacker := range killmail.Attackers {
+		if attacker.CharacterID == nil {
+			continue
+		}
+
+		p, ok := partyOf(attacker.AllianceID, attacker.CorporationID)
+		if !ok || seen[p] {
+			continue
+		}
+
+		seen[p] = true
+		parties = append(parties, p)
+	}
+
+	return parties
+
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/eveisesi/neo"
@@ -76,12 +78,24 @@ func (s *service) GetKillmailsKillmailIDKillmailHash(ctx context.Context, id uin
 		return nil, m
 	}
 
+	killmail, err := ParseKillmail(response, hash)
+	if err != nil {
+		m.Msg = errors.Wrapf(err, "unable to parse response body on request %s", path)
+		return nil, m
+	}
+
+	return killmail, m
+}
+
+// ParseKillmail converts a raw ESI killmail payload into a neo.Killmail. ESI does not include the hash
+// in the payload, so it must be provided by the caller
+func ParseKillmail(data []byte, hash string) (*neo.Killmail, error) {
+
 	esiKillmail := new(Killmail)
 
-	err = json.Unmarshal(response, esiKillmail)
+	err := json.Unmarshal(data, esiKillmail)
 	if err != nil {
-		m.Msg = errors.Wrapf(err, "unable to unmarshal response body on request %s", path)
-		return nil, m
+		return nil, errors.Wrap(err, "unable to unmarshal killmail")
 	}
 
 	esiKillmail.Hash = hash
@@ -89,9 +103,73 @@ func (s *service) GetKillmailsKillmailIDKillmailHash(ctx context.Context, id uin
 	var killmail = new(neo.Killmail)
 	err = copier.Copy(killmail, esiKillmail)
 	if err != nil {
-		m.Msg = err
+		return nil, errors.Wrap(err, "unable to copy killmail")
+	}
+
+	return killmail, nil
+
+}
+
+// GetCharactersCharacterIDKillmailsRecent makes a HTTP GET Request to the /characters/{character_id}/killmails/recent/
+// endpoint for the ids and hashes of the killmails a character was recently involved in
+//
+// Documentation: https://esi.evetech.net/ui/#/Killmails/get_characters_character_id_killmails_recent
+// Version: v1
+// Cache: 300 sec (5 Minutes)
+// Scope: esi-killmails.read_killmails.v1
+func (s *service) GetCharactersCharacterIDKillmailsRecent(ctx context.Context, id uint64, accessToken string, page uint) ([]*neo.Message, Meta) {
+	return s.recentKillmails(ctx, fmt.Sprintf("/v1/characters/%d/killmails/recent/", id), accessToken, page)
+}
+
+// GetCorporationsCorporationIDKillmailsRecent makes a HTTP GET Request to the /corporations/{corporation_id}/killmails/recent/
+// endpoint for the ids and hashes of the killmails a corporation was recently involved in
+//
+// Documentation: https://esi.evetech.net/ui/#/Killmails/get_corporations_corporation_id_killmails_recent
+// Version: v1
+// Cache: 300 sec (5 Minutes)
+// Scope: esi-killmails.read_corporation_killmails.v1
+func (s *service) GetCorporationsCorporationIDKillmailsRecent(ctx context.Context, id uint, accessToken string, page uint) ([]*neo.Message, Meta) {
+	return s.recentKillmails(ctx, fmt.Sprintf("/v1/corporations/%d/killmails/recent/", id), accessToken, page)
+}
+
+func (s *service) recentKillmails(ctx context.Context, path, accessToken string, page uint) ([]*neo.Message, Meta) {
+
+	query := url.Values{}
+	if page > 1 {
+		query.Set("page", strconv.FormatUint(uint64(page), 10))
+	}
+
+	response, m := s.request(ctx, request{
+		method: http.MethodGet,
+		path:   path,
+		query:  query.Encode(),
+		headers: map[string]string{
+			"Authorization": fmt.Sprintf("Bearer %s", accessToken),
+		},
+	})
+	if m.IsErr() {
 		return nil, m
 	}
 
-	return killmail, m
+	if m.Code != http.StatusOK {
+		return nil, m
+	}
+
+	var recent = make([]struct {
+		ID   uint   `json:"killmail_id"`
+		Hash string `json:"killmail_hash"`
+	}, 0)
+	err := json.Unmarshal(response, &recent)
+	if err != nil {
+		m.Msg = errors.Wrapf(err, "unable to unmarshal response body on request %s", path)
+		return nil, m
+	}
+
+	messages := make([]*neo.Message, 0, len(recent))
+	for _, killmail := range recent {
+		messages = append(messages, &neo.Message{ID: killmail.ID, Hash: killmail.Hash})
+	}
+
+	return messages, m
+
 }
@@ -40,6 +40,8 @@ type (
 
 		// Killmails
 		GetKillmailsKillmailIDKillmailHash(ctx context.Context, id uint, hash string) (*neo.Killmail, Meta)
+		GetCharactersCharacterIDKillmailsRecent(ctx context.Context, id uint64, accessToken string, page uint) ([]*neo.Message, Meta)
+		GetCorporationsCorporationIDKillmailsRecent(ctx context.Context, id uint, accessToken string, page uint) ([]*neo.Message, Meta)
 
 		// Market
 		HeadMarketsRegionIDTypes(ctx context.Context, regionID uint) Meta
@@ -59,6 +61,8 @@ type (
 	service struct {
 		client      *http.Client
 		redis       *redis.Client
+		scheme      string
+		host        string
 		ua          string
 		maxattempts uint
 	}
@@ -97,16 +101,24 @@ func (r Meta) IsErr() bool {
 	return r.Msg != nil
 }
 
-// New returns a default configuration for this package
+// New returns a default configuration for this package. host may be a bare hostname, in which case
+// https is assumed, or a full URL such as http://localhost:8080 to point the client at a local stub
 func New(redis *redis.Client, host, uagent string) Service {
 
 	client := &http.Client{
 		Timeout: time.Second * 3,
 	}
 
+	scheme := "https"
+	if uri, err := url.Parse(host); err == nil && uri.Scheme != "" && uri.Host != "" {
+		scheme, host = uri.Scheme, uri.Host
+	}
+
 	return &service{
 		redis:       redis,
 		client:      client,
+		scheme:      scheme,
+		host:        host,
 		ua:          uagent,
 		maxattempts: 3,
 	}
@@ -125,8 +137,8 @@ func (s *service) request(ctx context.Context, r request) ([]byte, Meta) {
 	}()
 
 	uri := url.URL{
-		Scheme:   "https",
-		Host:     "esi.evetech.net",
+		Scheme:   s.scheme,
+		Host:     s.host,
 		Path:     r.path,
 		RawQuery: r.query,
 	}
@@ -0,0 +1,161 @@
+package esikills
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/services/esi"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Run imports recent killmails on the configured interval until the context is cancelled
+func (s *service) Run(ctx context.Context) {
+
+	ticker := time.NewTicker(s.config.ESIKillmailImportInterval)
+	defer ticker.Stop()
+
+	for {
+		dispatched, err := s.Import(ctx)
+		if err != nil {
+			s.logger.WithContext(ctx).WithError(err).Error("failed to import recent killmails from esi")
+		}
+
+		s.logger.WithContext(ctx).WithField("dispatched", dispatched).Info("esi killmail import complete")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+
+}
+
+// Import makes a single pass over every enabled token, paging through the recent killmails of the character and,
+// when the token carries the corporation scope, of the character's corporation. Killmails that are not stored yet
+// are dispatched to the manual lane. The number of dispatched killmails is returned
+func (s *service) Import(ctx context.Context) (int, error) {
+
+	tokens, err := s.token.Tokens(ctx, neo.NewEqualOperator("disabled", false))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch tokens")
+	}
+
+	var (
+		dispatched   int
+		seen         = make(map[uint]bool)
+		corporations = make(map[uint]bool)
+	)
+
+	for _, token := range tokens {
+		entry := s.logger.WithContext(ctx).WithField("character_id", token.ID)
+
+		source, err := s.token.TokenSource(ctx, token.ID)
+		if err != nil {
+			entry.WithError(err).Error("failed to build token source")
+			continue
+		}
+
+		accessToken, err := source.Token()
+		if err != nil {
+			entry.WithError(err).Error("failed to retrieve access token")
+			continue
+		}
+
+		if token.HasScope(neo.ScopeReadKillmails) {
+			count, _ := s.importPages(ctx, entry, seen, func(ctx context.Context, page uint) ([]*neo.Message, esi.Meta) {
+				return s.esi.GetCharactersCharacterIDKillmailsRecent(ctx, token.ID, accessToken.AccessToken, page)
+			})
+			dispatched += count
+		}
+
+		if !token.HasScope(neo.ScopeReadCorporationKillmails) {
+			continue
+		}
+
+		character, err := s.character.Character(ctx, token.ID)
+		if err != nil {
+			entry.WithError(err).Error("failed to resolve character corporation")
+			continue
+		}
+
+		// Every director in a corporation sees the same killmails, so each corporation is only walked once per pass.
+		// A corporation is only marked as walked once a token was allowed to read it, so that a token without the
+		// director role does not keep the remaining tokens of its corporation from being tried
+		if corporations[character.CorporationID] {
+			continue
+		}
+
+		corpEntry := entry.WithField("corporation_id", character.CorporationID)
+		count, walked := s.importPages(ctx, corpEntry, seen, func(ctx context.Context, page uint) ([]*neo.Message, esi.Meta) {
+			return s.esi.GetCorporationsCorporationIDKillmailsRecent(ctx, character.CorporationID, accessToken.AccessToken, page)
+		})
+		dispatched += count
+		if walked {
+			corporations[character.CorporationID] = true
+		}
+	}
+
+	return dispatched, nil
+
+}
+
+type pageFetcher func(ctx context.Context, page uint) ([]*neo.Message, esi.Meta)
+
+// importPages walks every page returned by fetch and dispatches the killmails that have not been seen during this
+// pass and are not stored yet. It returns the number of dispatched killmails and whether the first page was fetched
+func (s *service) importPages(ctx context.Context, entry *logrus.Entry, seen map[uint]bool, fetch pageFetcher) (int, bool) {
+
+	dispatched := 0
+	for page := uint(1); ; page++ {
+		s.tracker.Watchman(ctx)
+
+		txn := s.newrelic.StartTransaction("esi-killmails-recent")
+		ctx := newrelic.NewContext(ctx, txn)
+
+		messages, m := fetch(ctx, page)
+		if m.IsErr() || m.Code != http.StatusOK {
+			txn.End()
+			// Characters without the director role receive a 403 from the corporation route, which is expected
+			entry.WithError(m.Msg).WithFields(logrus.Fields{
+				"page":        page,
+				"status_code": m.Code,
+			}).Warn("failed to fetch recent killmails from esi")
+			return dispatched, page > 1
+		}
+
+		for _, message := range messages {
+			if seen[message.ID] {
+				continue
+			}
+			seen[message.ID] = true
+
+			exists, err := s.killmails.Exists(ctx, message.ID)
+			if err != nil {
+				txn.NoticeError(err)
+				entry.WithError(err).WithField("id", message.ID).Error("failed to check if killmail exists")
+				continue
+			}
+
+			if exists {
+				continue
+			}
+
+			s.killmail.DispatchPayload(neo.LaneManual, message)
+			dispatched++
+		}
+
+		txn.End()
+
+		pages, err := strconv.ParseUint(m.Headers["X-Pages"], 10, 64)
+		if err != nil || uint64(page) >= pages {
+			return dispatched, true
+		}
+	}
+
+}
@@ -0,0 +1,57 @@
+package esikills
+
+import (
+	"context"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/services/character"
+	"github.com/eveisesi/neo/services/esi"
+	"github.com/eveisesi/neo/services/killmail"
+	"github.com/eveisesi/neo/services/token"
+	"github.com/eveisesi/neo/services/tracker"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/sirupsen/logrus"
+)
+
+// Service imports killmails that are only visible to authenticated characters and corporations by walking the
+// recent killmails of every stored SSO token and dispatching the ones we have not seen to the importer
+type Service interface {
+	Run(ctx context.Context)
+	Import(ctx context.Context) (int, error)
+}
+
+type service struct {
+	logger    *logrus.Logger
+	newrelic  *newrelic.Application
+	config    *neo.Config
+	esi       esi.Service
+	tracker   tracker.Service
+	character character.Service
+	killmail  killmail.Service
+	killmails neo.KillmailRepository
+	token     token.Service
+}
+
+func NewService(
+	logger *logrus.Logger,
+	newrelic *newrelic.Application,
+	config *neo.Config,
+	esi esi.Service,
+	tracker tracker.Service,
+	character character.Service,
+	killmail killmail.Service,
+	killmails neo.KillmailRepository,
+	token token.Service,
+) Service {
+	return &service{
+		logger,
+		newrelic,
+		config,
+		esi,
+		tracker,
+		character,
+		killmail,
+		killmails,
+		token,
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"time"
 
 	"github.com/eveisesi/neo"
-	"github.com/go-redis/redis/v8"
+	"github.com/eveisesi/neo/services/queue"
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/sirupsen/logrus"
 )
@@ -21,7 +21,7 @@ type Service interface {
 
 type service struct {
 	client   *http.Client
-	redis    *redis.Client
+	queue    queue.Service
 	logger   *logrus.Logger
 	config   *neo.Config
 	newrelic *newrelic.Application
@@ -35,7 +35,7 @@ const (
 
 func NewService(
 	client *http.Client,
-	redis *redis.Client,
+	queue queue.Service,
 	logger *logrus.Logger,
 	nr *newrelic.Application,
 	config *neo.Config,
@@ -44,7 +44,7 @@ func NewService(
 ) Service {
 	return &service{
 		client:   client,
-		redis:    redis,
+		queue:    queue,
 		logger:   logger,
 		newrelic: nr,
 		config:   config,
@@ -289,9 +289,9 @@ func (s *service) Run(startDateStr, endDateStr string, incrementer int64, stats
 		i := 0
 		for {
 
-			count, err := s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_PROCESSING, "-inf", "+inf").Result()
+			count, err := s.queue.Len(ctx, neo.LaneBackfill.Queue())
 			if err != nil {
-				s.logger.WithError(err).Fatal("unable to get count of redis zset")
+				s.logger.WithError(err).Fatal("unable to get length of processing queue")
 			}
 
 			if count == 0 {
@@ -337,7 +337,7 @@ func (s *service) handleHashes(ctx context.Context, missing []neo.Message) {
 	// Start the dispatch iterator
 	dispatched := 0
 
-	members := make([]*redis.Z, 0)
+	members := make([][]byte, 0)
 
 	// Start a loop over the hashes that we got from ZKill
 	for _, msg := range missing {
@@ -353,21 +353,21 @@ func (s *service) handleHashes(ctx context.Context, missing []neo.Message) {
 
 		dispatched++
 
-		members = append(members, &redis.Z{Score: float64(msg.ID), Member: data})
+		members = append(members, data)
 		if len(members) >= 250 {
-			_, err := s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_PROCESSING, members...).Result()
+			err := s.queue.Publish(ctx, neo.LaneBackfill.Queue(), members...)
 			if err != nil {
 				// Log error message
 				s.logger.WithError(err).Error("failed to add historical hashes to redis queue")
 				// Sleep for a second to see if this helps
 				time.Sleep(time.Second)
 			}
-			members = make([]*redis.Z, 0)
+			members = make([][]byte, 0)
 		}
 
 	}
 
-	_, err := s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_PROCESSING, members...).Result()
+	err := s.queue.Publish(ctx, neo.LaneBackfill.Queue(), members...)
 	if err != nil {
 		// Log error message
 		s.logger.Error("failed to add historical hashes to redis queue")
@@ -375,9 +375,9 @@ func (s *service) handleHashes(ctx context.Context, missing []neo.Message) {
 		time.Sleep(time.Second)
 	}
 
-	count, err := s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_PROCESSING, "-inf", "+inf").Result()
+	count, err := s.queue.Len(ctx, neo.LaneBackfill.Queue())
 	if err != nil {
-		s.logger.WithError(err).Fatal("unable to get count of redis zset")
+		s.logger.WithError(err).Fatal("unable to get length of processing queue")
 	}
 
 	s.logger.WithFields(logrus.Fields{
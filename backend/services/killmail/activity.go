@@ -0,0 +1,104 @@
+package killmail
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+	"github.com/sirkon/go-format"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxActivityDays caps how far back an activity heatmap looks
+	maxActivityDays = 365
+	// activityCacheDuration is how long a heatmap is cached. Heatmaps cover days, so a few minutes of lag is fine
+	activityCacheDuration = time.Minute * 10
+)
+
+// Activity buckets the kills and losses of an entity over the last number of days by the day of the week and the
+// hour of the day they happened at
+func (s *service) Activity(ctx context.Context, entity neo.StatEntity, id uint64, days int) (*neo.Activity, error) {
+
+	column, ok := entity.InvolvementColumn()
+	if !ok {
+		return nil, errors.Errorf("activity is not available for %s", entity)
+	}
+
+	if days < 1 || days > maxActivityDays {
+		return nil, errors.Errorf("days must be between 1 and %d", maxActivityDays)
+	}
+
+	var key = format.Formatm(neo.REDIS_ACTIVITY, format.Values{
+		"entity": entity,
+		"id":     id,
+		"days":   days,
+	})
+
+	entry := s.logger.WithFields(logrus.Fields{
+		"key":   key,
+		"class": "Activity",
+	})
+
+	cached, err := s.redis.Get(ctx, key).Bytes()
+	if err != nil && err.Error() != neo.ErrRedisNil.Error() {
+		return nil, err
+	}
+
+	if len(cached) > 0 {
+		var activity = new(neo.Activity)
+		err = json.Unmarshal(cached, activity)
+		if err == nil {
+			entry.Info("cache hit. returning activity")
+			return activity, nil
+		}
+		entry.WithError(err).Error("unable to unmarshal activity from cache")
+	}
+
+	entry.Info("cache miss, aggregating activity from db")
+
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), now.Day()-days, now.Hour(), 0, 0, 0, time.UTC)
+
+	kills, err := s.killmails.KillmailActivity(
+		ctx,
+		neo.NewEqualOperator("attackers."+column, id),
+		neo.NewGreaterThanEqualToOperator("killmailTime", since),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to aggregate kill activity")
+	}
+
+	losses, err := s.killmails.KillmailActivity(
+		ctx,
+		neo.NewEqualOperator("victim."+column, id),
+		neo.NewGreaterThanEqualToOperator("killmailTime", since),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to aggregate loss activity")
+	}
+
+	activity := neo.NewActivity(days)
+	for _, count := range kills {
+		activity.Kills[count.Weekday][count.Hour] = count.Count
+	}
+	for _, count := range losses {
+		activity.Losses[count.Weekday][count.Hour] = count.Count
+	}
+
+	data, err := json.Marshal(activity)
+	if err != nil {
+		entry.WithError(err).Error("unable to marshal activity for cache")
+		return activity, nil
+	}
+
+	err = s.redis.Set(ctx, key, data, activityCacheDuration).Err()
+	if err != nil {
+		entry.WithError(err).Error("failed to cache activity in redis")
+	}
+
+	return activity, nil
+
+}
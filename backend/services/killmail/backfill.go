@@ -0,0 +1,119 @@
+package killmail
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/korovkin/limiter"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// BackfillFilter narrows down the stored killmails that a backfill runs against. Zero values are ignored
+type BackfillFilter struct {
+	From          time.Time
+	To            time.Time
+	TypeIDs       []uint
+	CharacterID   uint64
+	CorporationID uint
+	AllianceID    uint
+}
+
+const backfillBatchSize = 500
+
+func (f BackfillFilter) operators() []*neo.Operator {
+
+	operators := make([]*neo.Operator, 0)
+	if !f.From.IsZero() {
+		operators = append(operators, neo.NewGreaterThanEqualToOperator("killmailTime", f.From))
+	}
+
+	if !f.To.IsZero() {
+		operators = append(operators, neo.NewLessThanOperator("killmailTime", f.To))
+	}
+
+	if len(f.TypeIDs) > 0 {
+		ids := make([]neo.OpValue, 0, len(f.TypeIDs))
+		for _, id := range f.TypeIDs {
+			ids = append(ids, id)
+		}
+
+		operators = append(operators, neo.NewOrOperator(
+			neo.NewInOperator("victim.shipTypeID", ids),
+			neo.NewInOperator("victim.items.itemTypeID", ids),
+			neo.NewInOperator("victim.items.items.itemTypeID", ids),
+		))
+	}
+
+	if f.CharacterID > 0 {
+		operators = append(operators, neo.NewOrOperator(
+			neo.NewEqualOperator("victim.characterID", f.CharacterID),
+			neo.NewEqualOperator("attackers.characterID", f.CharacterID),
+		))
+	}
+
+	if f.CorporationID > 0 {
+		operators = append(operators, neo.NewOrOperator(
+			neo.NewEqualOperator("victim.corporationID", f.CorporationID),
+			neo.NewEqualOperator("attackers.corporationID", f.CorporationID),
+		))
+	}
+
+	if f.AllianceID > 0 {
+		operators = append(operators, neo.NewOrOperator(
+			neo.NewEqualOperator("victim.allianceID", f.AllianceID),
+			neo.NewEqualOperator("attackers.allianceID", f.AllianceID),
+		))
+	}
+
+	return operators
+
+}
+
+// backfill pages through every stored killmail matching the filter in id order, calling fn for each killmail
+// with at most gLimit calls in flight
+func (s *service) backfill(ctx context.Context, name string, filter BackfillFilter, gLimit int64, fn func(killmail *neo.Killmail)) error {
+
+	var after uint
+
+	limit := limiter.NewConcurrencyLimiter(int(gLimit))
+	for {
+
+		operators := append(
+			filter.operators(),
+			neo.NewGreaterThanOperator("id", after),
+			neo.NewOrderOperator("id", neo.SortAsc),
+			neo.NewLimitOperator(backfillBatchSize),
+		)
+
+		killmails, err := s.killmails.Killmails(ctx, operators...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch killmails to %s", name)
+		}
+
+		if len(killmails) == 0 {
+			return nil
+		}
+
+		for _, killmail := range killmails {
+			killmail := killmail
+			limit.Execute(func() {
+				fn(killmail)
+			})
+		}
+
+		limit.Wait()
+
+		after = killmails[len(killmails)-1].ID
+
+		s.logger.WithContext(ctx).WithFields(logrus.Fields{
+			"after": after,
+		}).Infof("%s batch complete", name)
+
+		if len(killmails) < backfillBatchSize {
+			return nil
+		}
+	}
+
+}
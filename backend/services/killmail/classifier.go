@@ -0,0 +1,353 @@
+package killmail
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/eveisesi/neo"
+)
+
+// Classifier decides whether a killmail should carry the tag it is registered against
+type Classifier func(ctx context.Context, killmail *neo.Killmail) bool
+
+type classifierRule struct {
+	tag      neo.KillmailTag
+	classify Classifier
+}
+
+const (
+	// Corporations with an ID below this are NPC corporations
+	npcCorporationCutoff = 98000000
+
+	// Wormhole systems occupy this ID range. Abyssal systems start at the upper bound
+	wormholeSystemMin = 31000000
+	wormholeSystemMax = 32000000
+
+	highsecSecurity = 0.45
+	// Attackers below this security status are treated as gankers when a killmail occurs in highsec
+	gankerSecurityStatus = -2.0
+
+	groupCapsule  = 29
+	groupShuttle  = 31
+	groupCorvette = 237
+	groupCitizen  = 2001
+	// groupRookie is the legacy group some starter ships were placed in before citizen ships existed
+	groupRookie = 361
+
+	categoryStarbase  = 23
+	categoryStructure = 65
+)
+
+// Groups that are never considered when looking for an awox, since anybody can end up in one
+var disposableGroups = map[uint]bool{
+	groupCapsule:  true,
+	groupShuttle:  true,
+	groupCorvette: true,
+	groupRookie:   true,
+	groupCitizen:  true,
+}
+
+// Titan, Dreadnought, Carrier, Supercarrier, Capital Industrial Ship, Force Auxiliary, Lancer Dreadnought
+var capitalGroups = map[uint]bool{
+	30: true, 485: true, 547: true, 659: true, 883: true, 1538: true, 4594: true,
+}
+
+// RegisterClassifier adds a rule to the classifier registry. Rules should be registered before the
+// service starts processing killmails
+func (s *service) RegisterClassifier(tag neo.KillmailTag, classifier Classifier) {
+	s.classifiers = append(s.classifiers, &classifierRule{tag: tag, classify: classifier})
+}
+
+func (s *service) registerDefaultClassifiers() {
+	s.RegisterClassifier(neo.KillmailTagAwox, s.isAwox)
+	s.RegisterClassifier(neo.KillmailTagNPC, s.isNPC)
+	s.RegisterClassifier(neo.KillmailTagSolo, s.isSolo)
+	s.RegisterClassifier(neo.KillmailTagHighsecGank, s.isHighsecGank)
+	s.RegisterClassifier(neo.KillmailTagCapital, s.isCapitalInvolved)
+	s.RegisterClassifier(neo.KillmailTagStructure, s.isStructureLoss)
+	s.RegisterClassifier(neo.KillmailTagPod, s.isPodKill)
+	s.RegisterClassifier(neo.KillmailTagWormhole, s.isWormhole)
+	s.RegisterClassifier(neo.KillmailTagSmallGang, s.isSmallGang)
+	s.RegisterClassifier(neo.KillmailTagBlob, s.isBlob)
+}
+
+// classify runs every registered rule against the killmail and replaces its tags with the tags of the rules
+// that matched. The awox, NPC and solo flags are kept in sync with their tags
+func (s *service) classify(ctx context.Context, killmail *neo.Killmail) {
+
+	tags := make([]neo.KillmailTag, 0)
+	for _, rule := range s.classifiers {
+		if rule.classify(ctx, killmail) {
+			tags = append(tags, rule.tag)
+		}
+	}
+
+	killmail.Tags = tags
+	killmail.IsAwox = killmail.HasTag(neo.KillmailTagAwox)
+	killmail.IsNPC = killmail.HasTag(neo.KillmailTagNPC)
+	killmail.IsSolo = killmail.HasTag(neo.KillmailTagSolo)
+
+}
+
+func (s *service) isAwox(ctx context.Context, killmail *neo.Killmail) bool {
+
+	if killmail.Victim == nil {
+		return false
+	}
+
+	if killmail.Attackers == nil {
+		return false
+	}
+
+	if killmail.Victim.CorporationID == nil {
+		return false
+	}
+
+	victimCorporationID := *killmail.Victim.CorporationID
+	// Victim is in an NPC Corp. This is not an AWOX since characters
+	// cannot choose which NPC Corp they are in
+	if victimCorporationID < npcCorporationCutoff {
+		return false
+	}
+
+	shipType, err := s.universe.Type(ctx, killmail.Victim.ShipTypeID)
+	if err != nil {
+		return false
+	}
+
+	if disposableGroups[shipType.GroupID] {
+		return false
+	}
+
+	for _, attacker := range killmail.Attackers {
+		if attacker.CorporationID == nil {
+			continue
+		}
+
+		if attacker.ShipTypeID == nil {
+			continue
+		}
+
+		attackerShip, err := s.universe.Type(ctx, *attacker.ShipTypeID)
+		if err != nil {
+			continue
+		}
+
+		if disposableGroups[attackerShip.GroupID] {
+			continue
+		}
+
+		if *attacker.CorporationID == victimCorporationID {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+func (s *service) isNPC(ctx context.Context, killmail *neo.Killmail) bool {
+
+	if killmail.Victim == nil {
+		return false
+	}
+
+	if killmail.Attackers == nil {
+		return false
+	}
+
+	for _, attacker := range killmail.Attackers {
+		if attacker.CorporationID == nil {
+			continue
+		}
+
+		if *attacker.CorporationID >= npcCorporationCutoff {
+			return false
+		}
+	}
+
+	return true
+
+}
+
+func (s *service) isSolo(ctx context.Context, killmail *neo.Killmail) bool {
+
+	if killmail.Victim == nil {
+		return false
+	}
+
+	if len(killmail.Attackers) != 1 {
+		return false
+	}
+
+	attacker := killmail.Attackers[0]
+
+	if attacker.CorporationID == nil {
+		return false
+	}
+
+	return *attacker.CorporationID >= npcCorporationCutoff
+
+}
+
+// isHighsecGank matches player victims killed in highsec outside of a war by at least one player with a
+// criminal security status
+func (s *service) isHighsecGank(ctx context.Context, killmail *neo.Killmail) bool {
+
+	if killmail.Victim == nil || killmail.Victim.CharacterID == nil || killmail.WarID != nil {
+		return false
+	}
+
+	system, err := s.universe.SolarSystem(ctx, killmail.SolarSystemID)
+	if err != nil || system.Security < highsecSecurity {
+		return false
+	}
+
+	for _, attacker := range killmail.Attackers {
+		if attacker.CharacterID != nil && attacker.SecurityStatus <= gankerSecurityStatus {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+// isCapitalInvolved matches killmails where the victim or any attacker was flying a capital ship
+func (s *service) isCapitalInvolved(ctx context.Context, killmail *neo.Killmail) bool {
+
+	if killmail.Victim != nil {
+		shipType, err := s.universe.Type(ctx, killmail.Victim.ShipTypeID)
+		if err == nil && capitalGroups[shipType.GroupID] {
+			return true
+		}
+	}
+
+	for _, attacker := range killmail.Attackers {
+		if attacker.ShipTypeID == nil {
+			continue
+		}
+
+		shipType, err := s.universe.Type(ctx, *attacker.ShipTypeID)
+		if err == nil && capitalGroups[shipType.GroupID] {
+			return true
+		}
+	}
+
+	return false
+
+}
+
+// isStructureLoss matches killmails where the victim is an upwell structure or a starbase structure
+func (s *service) isStructureLoss(ctx context.Context, killmail *neo.Killmail) bool {
+
+	if killmail.Victim == nil {
+		return false
+	}
+
+	shipType, err := s.universe.Type(ctx, killmail.Victim.ShipTypeID)
+	if err != nil {
+		return false
+	}
+
+	group, err := s.universe.TypeGroup(ctx, shipType.GroupID)
+	if err != nil {
+		return false
+	}
+
+	return group.CategoryID == categoryStructure || group.CategoryID == categoryStarbase
+
+}
+
+func (s *service) isPodKill(ctx context.Context, killmail *neo.Killmail) bool {
+
+	if killmail.Victim == nil {
+		return false
+	}
+
+	shipType, err := s.universe.Type(ctx, killmail.Victim.ShipTypeID)
+	if err != nil {
+		return false
+	}
+
+	return shipType.GroupID == groupCapsule
+
+}
+
+func (s *service) isWormhole(ctx context.Context, killmail *neo.Killmail) bool {
+	return killmail.SolarSystemID >= wormholeSystemMin && killmail.SolarSystemID < wormholeSystemMax
+}
+
+// isSmallGang matches player killmails with between two and KillmailSmallGangMax player attackers
+func (s *service) isSmallGang(ctx context.Context, killmail *neo.Killmail) bool {
+	count := playerAttackers(killmail)
+	return count >= 2 && count <= s.config.KillmailSmallGangMax
+}
+
+// isBlob matches killmails with at least KillmailBlobThreshold player attackers
+func (s *service) isBlob(ctx context.Context, killmail *neo.Killmail) bool {
+	return playerAttackers(killmail) >= s.config.KillmailBlobThreshold
+}
+
+func playerAttackers(killmail *neo.Killmail) int {
+
+	count := 0
+	for _, attacker := range killmail.Attackers {
+		if attacker.CharacterID != nil {
+			count++
+		}
+	}
+
+	return count
+
+}
+
+// Reclassify re-runs the classifier registry against every stored killmail matching the filter and persists the
+// killmails whose tags changed. The number of killmails that changed is returned
+func (s *service) Reclassify(ctx context.Context, filter BackfillFilter, gLimit int64) (int64, error) {
+
+	var changed int64
+
+	err := s.backfill(ctx, "reclassify", filter, gLimit, func(killmail *neo.Killmail) {
+		before := killmail.Tags
+
+		s.classify(ctx, killmail)
+
+		// Killmails stored before tags existed have no tags field at all and always need to be written
+		if before != nil && sameTags(before, killmail.Tags) {
+			return
+		}
+
+		err := s.killmails.UpdateKillmail(ctx, killmail.ID, killmail)
+		if err != nil {
+			s.logger.WithContext(ctx).WithError(err).WithField("id", killmail.ID).Error("failed to persist reclassified killmail")
+			return
+		}
+
+		atomic.AddInt64(&changed, 1)
+	})
+
+	return atomic.LoadInt64(&changed), err
+
+}
+
+func sameTags(a, b []neo.KillmailTag) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	seen := make(map[neo.KillmailTag]bool, len(a))
+	for _, tag := range a {
+		seen[tag] = true
+	}
+
+	for _, tag := range b {
+		if !seen[tag] {
+			return false
+		}
+	}
+
+	return true
+
+}
@@ -0,0 +1,162 @@
+package killmail
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/eveisesi/neo"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// DeadLetters returns up to limit messages from the dead letter queue, highest killmail id first
+func (s *service) DeadLetters(ctx context.Context, limit int64) ([]*neo.Message, int64, error) {
+
+	count, err := s.redis.ZCard(ctx, neo.QUEUES_KILLMAIL_DLQ).Result()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count dead letter queue")
+	}
+
+	members, err := s.redis.ZRevRange(ctx, neo.QUEUES_KILLMAIL_DLQ, 0, limit-1).Result()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to fetch dead letter queue")
+	}
+
+	messages, err := s.decodeDeadLetters(members)
+
+	return messages, count, err
+
+}
+
+// DeadLetter returns every message in the dead letter queue for the provided killmail id
+func (s *service) DeadLetter(ctx context.Context, id uint) ([]*neo.Message, error) {
+
+	members, err := s.deadLetterMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.decodeDeadLetters(members)
+
+}
+
+// ReplayDeadLetters moves messages from the dead letter queue onto the manual processing lane with a fresh
+// retry budget. If no ids are provided, the entire dead letter queue is replayed
+func (s *service) ReplayDeadLetters(ctx context.Context, ids ...uint) (int, error) {
+
+	var members []string
+	if len(ids) == 0 {
+		all, err := s.redis.ZRange(ctx, neo.QUEUES_KILLMAIL_DLQ, 0, -1).Result()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to fetch dead letter queue")
+		}
+		members = all
+	}
+
+	for _, id := range ids {
+		byID, err := s.deadLetterMembers(ctx, id)
+		if err != nil {
+			return 0, err
+		}
+		members = append(members, byID...)
+	}
+
+	replayed := 0
+	for _, member := range members {
+		var message = neo.Message{}
+		err := json.Unmarshal([]byte(member), &message)
+		if err != nil {
+			s.logger.WithError(err).WithField("member", member).Error("failed to unmarshal dead letter, skipping")
+			continue
+		}
+
+		payload, err := json.Marshal(neo.Message{ID: message.ID, Hash: message.Hash})
+		if err != nil {
+			return replayed, errors.Wrap(err, "failed to marshal dead letter")
+		}
+
+		// The message is only removed from the dead letter queue once it is back on a lane, so that a failed
+		// publish leaves it in place to be replayed again
+		err = s.queue.Publish(ctx, neo.LaneManual.Queue(), payload)
+		if err != nil {
+			return replayed, errors.Wrap(err, "failed to publish dead letter to manual lane")
+		}
+
+		_, err = s.redis.ZRem(ctx, neo.QUEUES_KILLMAIL_DLQ, member).Result()
+		if err != nil {
+			return replayed, errors.Wrap(err, "failed to remove message from dead letter queue")
+		}
+
+		s.logger.WithFields(logrus.Fields{
+			"id":       message.ID,
+			"hash":     message.Hash,
+			"attempts": message.Attempts,
+		}).Info("dead letter replayed")
+		replayed++
+	}
+
+	return replayed, nil
+
+}
+
+// PurgeDeadLetters removes messages from the dead letter queue without processing them. If no ids are provided,
+// the entire dead letter queue is purged
+func (s *service) PurgeDeadLetters(ctx context.Context, ids ...uint) (int64, error) {
+
+	if len(ids) == 0 {
+		count, err := s.redis.ZCard(ctx, neo.QUEUES_KILLMAIL_DLQ).Result()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to count dead letter queue")
+		}
+
+		_, err = s.redis.Del(ctx, neo.QUEUES_KILLMAIL_DLQ).Result()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to purge dead letter queue")
+		}
+
+		return count, nil
+	}
+
+	purged := int64(0)
+	for _, id := range ids {
+		score := strconv.FormatUint(uint64(id), 10)
+		removed, err := s.redis.ZRemRangeByScore(ctx, neo.QUEUES_KILLMAIL_DLQ, score, score).Result()
+		if err != nil {
+			return purged, errors.Wrap(err, "failed to purge message from dead letter queue")
+		}
+		purged += removed
+	}
+
+	return purged, nil
+
+}
+
+func (s *service) deadLetterMembers(ctx context.Context, id uint) ([]string, error) {
+
+	score := strconv.FormatUint(uint64(id), 10)
+	members, err := s.redis.ZRangeByScore(ctx, neo.QUEUES_KILLMAIL_DLQ, &redis.ZRangeBy{Min: score, Max: score}).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch message from dead letter queue")
+	}
+
+	return members, nil
+
+}
+
+func (s *service) decodeDeadLetters(members []string) ([]*neo.Message, error) {
+
+	messages := make([]*neo.Message, 0, len(members))
+	for _, member := range members {
+		var message = new(neo.Message)
+		err := json.Unmarshal([]byte(member), message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal dead letter")
+		}
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+
+}
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/eveisesi/neo"
-	"github.com/go-redis/redis/v8"
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
@@ -169,9 +168,9 @@ func (s *service) HistoryExporter(min, max, direction string, overrideCurrent, d
 		if datehold && threshold > 0 {
 			i := 0
 			for {
-				count, err := s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_PROCESSING, "-inf", "+inf").Result()
+				count, err := s.queue.Len(ctx, neo.LaneBackfill.Queue())
 				if err != nil {
-					s.logger.WithError(err).Fatal("unable to get count of redis zset")
+					s.logger.WithError(err).Fatal("unable to get length of processing queue")
 				}
 
 				if count < threshold {
@@ -207,7 +206,7 @@ func (s *service) handleHashes(ctx context.Context, hashes map[string]string) {
 	// Start the dispatch iterator
 	dispatched := 0
 
-	members := make([]*redis.Z, 0)
+	members := make([][]byte, 0)
 
 	// Start a loop over the hashes that we got from ZKill
 	for id, hash := range hashes {
@@ -232,21 +231,21 @@ func (s *service) handleHashes(ctx context.Context, hashes map[string]string) {
 
 		dispatched++
 
-		members = append(members, &redis.Z{Score: float64(killmailID), Member: msg})
+		members = append(members, msg)
 		if len(members) >= 250 {
-			_, err := s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_PROCESSING, members...).Result()
+			err := s.queue.Publish(ctx, neo.LaneBackfill.Queue(), members...)
 			if err != nil {
 				// Log error message
 				s.logger.Error("failed to add historical hashes to redis queue")
 				// Sleep for a second to see if this helps
 				time.Sleep(time.Second)
 			}
-			members = make([]*redis.Z, 0)
+			members = make([][]byte, 0)
 		}
 
 	}
 
-	_, err = s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_PROCESSING, members...).Result()
+	err = s.queue.Publish(ctx, neo.LaneBackfill.Queue(), members...)
 	if err != nil {
 		// Log error message
 		s.logger.Error("failed to add historical hashes to redis queue")
@@ -254,9 +253,9 @@ func (s *service) handleHashes(ctx context.Context, hashes map[string]string) {
 		time.Sleep(time.Second)
 	}
 
-	count, err := s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_PROCESSING, "-inf", "+inf").Result()
+	count, err := s.queue.Len(ctx, neo.LaneBackfill.Queue())
 	if err != nil {
-		s.logger.WithError(err).Fatal("unable to get count of redis zset")
+		s.logger.WithError(err).Fatal("unable to get length of processing queue")
 	}
 
 	s.logger.WithFields(logrus.Fields{
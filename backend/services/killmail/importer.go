@@ -3,7 +3,9 @@ package killmail
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/eveisesi/neo"
@@ -46,33 +48,42 @@ func (s *service) Importer(gLimit, gSleep int64) error {
 			continue
 		}
 
-		count, err := s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_PROCESSING, "-inf", "+inf").Result()
+		_, err = s.requeueDueRetries(ctx)
 		if err != nil {
 			txn.NoticeError(err)
-			s.logger.WithError(err).Error("unable to determine count of message queue")
+			s.logger.WithError(err).Error("failed to requeue due killmail retries")
+		}
+
+		messages, err := s.consumeLanes(ctx, gLimit)
+		if err != nil {
+			txn.NoticeError(err)
+			s.logger.WithError(err).Error("unable to consume messages from queue")
 			time.Sleep(time.Second * 2)
 			continue
 		}
 
-		if count == 0 {
+		if len(messages) == 0 {
 			txn.Ignore()
 			s.logger.Info("message queue is empty")
-			time.Sleep(time.Second * 2)
 			continue
 		}
 
-		results, err := s.redis.ZPopMax(ctx, neo.QUEUES_KILLMAIL_PROCESSING, gLimit).Result()
-		if err != nil {
-			txn.NoticeError(err)
-			s.logger.WithError(err).Fatal("unable to retrieve hashes from queue")
-		}
-
-		for i, result := range results {
+		for i, message := range messages {
 			s.tracker.Watchman(ctx)
 
-			message := result.Member.(string)
+			i, message := i, message
 			limit.ExecuteWithTicket(func(workerID int) {
-				s.handleMessage(ctx, []byte(message), i, gSleep)
+				// Failures that have been scheduled for a retry or dead lettered are acknowledged. Messages whose
+				// failure could not be recorded are left pending so that they are redelivered once the visibility
+				// timeout expires
+				if !s.handleMessage(ctx, message.lane, message.Payload, i, gSleep) {
+					return
+				}
+
+				err := s.queue.Ack(ctx, message.Stream, message.ID)
+				if err != nil {
+					s.logger.WithError(err).WithField("message_id", message.ID).Error("failed to ack message")
+				}
 			})
 		}
 
@@ -80,7 +91,8 @@ func (s *service) Importer(gLimit, gSleep int64) error {
 	}
 }
 
-func (s *service) handleMessage(ctx context.Context, message []byte, workerID int, sleep int64) {
+// handleMessage processes a message and reports whether it can be acknowledged
+func (s *service) handleMessage(ctx context.Context, lane neo.Lane, message []byte, workerID int, sleep int64) bool {
 
 	txn := s.newrelic.StartTransaction("handleMessage")
 	defer txn.End()
@@ -88,51 +100,44 @@ func (s *service) handleMessage(ctx context.Context, message []byte, workerID in
 
 	entry := s.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"worker": workerID,
+		"lane":   lane,
 	})
 
 	killmail, err := s.ProcessMessage(ctx, entry, message)
 	if err != nil {
 		txn.NoticeError(err)
 		s.logger.WithContext(ctx).WithError(err).Error("failed to handle message")
-		return
+		err = s.retryMessage(ctx, lane, entry, message, err)
+		if err != nil {
+			entry.WithError(err).Error("failed to record failed message, leaving it pending")
+			return false
+		}
+		return true
 	}
 
 	// Killmails we already know about an have processed come back as nil from the processor
 	if killmail == nil {
 		txn.Ignore()
-		return
+		return true
+	}
+
+	// Backfilled killmails are never notified on, however recent they are
+	if lane != neo.LaneBackfill && time.Since(killmail.KillmailTime) <= s.config.NotificationMaxAge {
+		s.dispatchNotifications(ctx, entry, killmail)
 	}
 
+	s.publishStats(ctx, entry, killmail)
+
 	now := time.Now()
 
 	if killmail.KillmailTime.After(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)) {
-		msg, err := json.Marshal(neo.Message{
-			ID:   killmail.ID,
-			Hash: killmail.Hash,
-		})
-		if err != nil {
-			entry.WithError(err).Error("failed to marshal message for stats queue")
-			return
-		}
-		member := &redis.Z{Score: float64(killmail.ID), Member: msg}
-		if s.config.SlackNotifierEnabled {
-			threshold := s.config.SlackNotifierValueThreshold * 1000000
-			now := time.Now()
-			if killmail.TotalValue >= float64(threshold) && killmail.KillmailTime.After(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)) {
-				_, err = s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION, member).Result()
-				if err != nil {
-					entry.WithError(err).Error("failed to publish message to notifications queue")
-				}
-			}
-		}
-
 		feedPayload, err := json.Marshal(killmail)
 		if err != nil {
 			entry.WithError(err).Error("failed to marshal payload for feed")
-			return
+			return true
 		}
 		entry.Info("killmail successfully imported, publishing to feed")
-		err = s.redis.Publish(ctx, "killmail-feed", feedPayload).Err()
+		err = s.redis.Publish(ctx, neo.REDIS_KILLMAIL_FEED, feedPayload).Err()
 		if err != nil {
 			entry.WithError(err).Error("failed to publish payload to feed")
 		}
@@ -140,6 +145,8 @@ func (s *service) handleMessage(ctx context.Context, message []byte, workerID in
 
 	time.Sleep(time.Millisecond * time.Duration(sleep))
 
+	return true
+
 }
 
 func (s *service) ProcessMessage(ctx context.Context, entry *logrus.Entry, message []byte) (*neo.Killmail, error) {
@@ -159,14 +166,6 @@ func (s *service) ProcessMessage(ctx context.Context, entry *logrus.Entry, messa
 		"hash": payload.Hash,
 	})
 
-	exists, err := s.killmails.Exists(ctx, payload.ID)
-	if err != nil {
-		txn.NoticeError(err)
-		entry.WithError(err).
-			Error("error encountered checking if killmail exists")
-		return nil, err
-	}
-
 	killmail, m := s.esi.GetKillmailsKillmailIDKillmailHash(ctx, payload.ID, payload.Hash)
 	if m.IsErr() {
 		txn.NoticeError(m.Msg)
@@ -175,14 +174,12 @@ func (s *service) ProcessMessage(ctx context.Context, entry *logrus.Entry, messa
 			"path":  m.Path,
 			"query": m.Query,
 		}).Error("failed to fetch killmail from esi")
-		if m.Code >= 500 {
-			s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_PROCESSING, &redis.Z{Score: float64(payload.ID), Member: message})
-		}
-		return nil, err
+		return nil, errors.Wrap(m.Msg, "failed to fetch killmail from esi")
 	}
 
 	if m.Code != 200 {
-		txn.NoticeError(errors.New("unexpected response code from esi"))
+		err = errors.Errorf("unexpected response code %d from esi", m.Code)
+		txn.NoticeError(err)
 		entry.WithFields(logrus.Fields{
 			"code":  m.Code,
 			"path":  m.Path,
@@ -190,10 +187,32 @@ func (s *service) ProcessMessage(ctx context.Context, entry *logrus.Entry, messa
 		}).Error("unexpected response code from esi")
 
 		if m.Code == http.StatusUnprocessableEntity {
+			// The hash is invalid, retrying will never succeed
 			s.redis.ZAdd(ctx, neo.ZKB_INVALID_HASH, &redis.Z{Score: float64(payload.ID), Member: message})
-			return nil, err
+			return nil, nil
 		}
-		s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_PROCESSING, &redis.Z{Score: float64(payload.ID), Member: message})
+		return nil, err
+	}
+
+	if s.config.BackupEnabled {
+		s.backup.BackupKillmail(ctx, killmail.KillmailTime, payload, m.Data)
+	}
+
+	return s.importKillmail(ctx, entry, killmail)
+
+}
+
+// importKillmail records the hash of a killmail fetched from ESI and, if the killmail has not been seen before,
+// resolves its entities, values it and persists it
+func (s *service) importKillmail(ctx context.Context, entry *logrus.Entry, killmail *neo.Killmail) (*neo.Killmail, error) {
+
+	txn := newrelic.FromContext(ctx)
+
+	exists, err := s.killmails.Exists(ctx, killmail.ID)
+	if err != nil {
+		txn.NoticeError(err)
+		entry.WithError(err).
+			Error("error encountered checking if killmail exists")
 		return nil, err
 	}
 
@@ -215,10 +234,6 @@ func (s *service) ProcessMessage(ctx context.Context, entry *logrus.Entry, messa
 
 	killmail.Victim.KillmailID = killmail.ID
 
-	date := killmail.KillmailTime
-	shipValue := s.market.FetchTypePrice(killmail.Victim.ShipTypeID, date)
-	killmail.Victim.ShipValue = shipValue
-
 	victimShipType, err := s.universe.Type(ctx, killmail.Victim.ShipTypeID)
 	if err != nil {
 		entry.WithError(err).Error("error encountered looking up type information for victim ship")
@@ -250,25 +265,8 @@ func (s *service) ProcessMessage(ctx context.Context, entry *logrus.Entry, messa
 		}
 	}
 
-	destroyedValue := float64(0)
-	droppedValue := float64(0)
-
 	for _, item := range killmail.Victim.Items {
 		item.KillmailID = killmail.ID
-		itemValue := float64(0)
-		if item.Singleton != 2 {
-			itemValue = s.market.FetchTypePrice(item.ItemTypeID, date)
-		} else {
-			itemValue = 0.01
-		}
-
-		item.ItemValue = itemValue
-		if item.QuantityDestroyed != nil && *item.QuantityDestroyed > 0 {
-			destroyedValue += item.ItemValue * float64(*item.QuantityDestroyed)
-		}
-		if item.QuantityDropped != nil && *item.QuantityDropped > 0 {
-			droppedValue += item.ItemValue * float64(*item.QuantityDropped)
-		}
 		if len(item.Items) > 0 {
 			item.IsParent = true
 		}
@@ -276,54 +274,25 @@ func (s *service) ProcessMessage(ctx context.Context, entry *logrus.Entry, messa
 		itemType, err := s.universe.Type(ctx, item.ItemTypeID)
 		if err != nil {
 			entry.WithField("item_id", item.ItemTypeID).WithError(err).Error("failed to fetch type infor for type")
-			continue
+		} else {
+			item.ItemGroupID = itemType.GroupID
 		}
 
-		item.ItemGroupID = itemType.GroupID
-	}
+		for _, subItem := range item.Items {
+			subItem.KillmailID = killmail.ID
 
-	for _, item := range killmail.Victim.Items {
-		if len(item.Items) > 0 {
-			for _, subItem := range item.Items {
-				subItem.KillmailID = killmail.ID
-				subItemValue := float64(0)
-				if subItem.Singleton != 2 {
-					subItemValue = s.market.FetchTypePrice(subItem.ItemTypeID, date)
-				} else {
-					subItemValue = 0.01
-				}
-				subItem.ItemValue = subItemValue
-
-				if subItem.QuantityDestroyed != nil && *subItem.QuantityDestroyed > 0 {
-					destroyedValue += subItemValue * float64(*subItem.QuantityDestroyed)
-				}
-				if subItem.QuantityDropped != nil && *subItem.QuantityDropped > 0 {
-					droppedValue += subItemValue * float64(*subItem.QuantityDropped)
-				}
-
-				subItemType, err := s.universe.Type(ctx, subItem.ItemTypeID)
-				if err != nil {
-					entry.WithField("item_id", subItem.ItemTypeID).WithError(err).Error("failed to fetch type infor for type")
-					continue
-				}
-
-				subItem.ItemGroupID = subItemType.GroupID
+			subItemType, err := s.universe.Type(ctx, subItem.ItemTypeID)
+			if err != nil {
+				entry.WithField("item_id", subItem.ItemTypeID).WithError(err).Error("failed to fetch type infor for type")
+				continue
 			}
+
+			subItem.ItemGroupID = subItemType.GroupID
 		}
 	}
 
-	fittedValue := s.calculatedFittedValue(killmail.Victim.Items)
-	fittedValue += shipValue
-	destroyedValue += shipValue
-	sum := droppedValue + destroyedValue
-
-	killmail.IsAwox = s.calcIsAwox(ctx, killmail)
-	killmail.IsNPC = s.calcIsNPC(ctx, killmail)
-	killmail.IsSolo = s.calcIsSolo(ctx, killmail)
-	killmail.DestroyedValue = destroyedValue
-	killmail.DroppedValue = droppedValue
-	killmail.FittedValue = fittedValue
-	killmail.TotalValue = sum
+	s.valuate(killmail)
+	s.classify(ctx, killmail)
 
 	err = s.killmails.CreateKillmail(ctx, killmail)
 	if err != nil {
@@ -336,113 +305,145 @@ func (s *service) ProcessMessage(ctx context.Context, entry *logrus.Entry, messa
 	return killmail, nil
 }
 
-func (s *service) calcIsAwox(ctx context.Context, killmail *neo.Killmail) bool {
+// publishStats queues a newly stored killmail for the stats consumer so that it is added to the counters
+func (s *service) publishStats(ctx context.Context, entry *logrus.Entry, killmail *neo.Killmail) {
 
-	if killmail.Victim == nil {
-		return false
+	payload, err := json.Marshal(neo.Message{ID: killmail.ID, Hash: killmail.Hash})
+	if err != nil {
+		entry.WithError(err).Error("failed to marshal payload for stats")
+		return
 	}
 
-	if killmail.Attackers == nil {
-		return false
+	err = s.queue.Publish(ctx, neo.QUEUES_KILLMAIL_STATS, payload)
+	if err != nil {
+		entry.WithError(err).Error("failed to publish payload to stats queue")
 	}
 
-	if killmail.Victim.CorporationID == nil {
-		return false
-	}
+}
 
-	victimCorporationID := *killmail.Victim.CorporationID
-	// Victim is in an NPC Corp. This is not an AWOX since characters
-	// cannot choose which NPC Corp they are in
-	if victimCorporationID < 98000000 {
-		return false
-	}
+// retryMessage records the failure on the message and schedules it to be pushed back onto the lane it was consumed
+// from once its retry delay has passed. Once a message has been attempted KillmailMaxAttempts times it is moved to the
+// dead letter queue instead. An error is returned when the failure could not be recorded and the message must not be
+// acknowledged
+func (s *service) retryMessage(ctx context.Context, lane neo.Lane, entry *logrus.Entry, message []byte, cause error) error {
 
-	shipType, err := s.universe.Type(ctx, killmail.Victim.ShipTypeID)
+	var payload = neo.Message{}
+	err := json.Unmarshal(message, &payload)
 	if err != nil {
-		return false
+		// A message that cannot be decoded will never succeed, so there is nothing to retry
+		entry.WithError(err).WithField("message", string(message)).Error("failed to unmarshal message, unable to retry")
+		return nil
 	}
 
-	switch shipType.GroupID {
-	// Capsule, Shuttle, Corvette, Citizen Ships
-	case 29, 31, 237, 361, 2001:
-		return false
-	}
+	payload.Attempts++
+	payload.LastError = cause.Error()
+	payload.LastAttempt = time.Now().Unix()
 
-	for _, attacker := range killmail.Attackers {
-		if attacker.CorporationID == nil {
-			continue
-		}
+	entry = entry.WithFields(logrus.Fields{
+		"id":       payload.ID,
+		"hash":     payload.Hash,
+		"attempts": payload.Attempts,
+	})
 
-		if attacker.ShipTypeID == nil {
-			continue
-		}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal message for retry")
+	}
 
-		attackerShip, err := s.universe.Type(ctx, *attacker.ShipTypeID)
+	if payload.Attempts >= s.config.KillmailMaxAttempts {
+		_, err = s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_DLQ, &redis.Z{Score: float64(payload.ID), Member: string(data)}).Result()
 		if err != nil {
-			continue
+			return errors.Wrap(err, "failed to push message to dead letter queue")
 		}
 
-		switch attackerShip.GroupID {
-		// Capsule, Shuttle, Corvette, Citizen Ships
-		case 29, 31, 237, 361, 2001:
-			goto LoopEnd
-		}
+		entry.Warn("retry budget exhausted, message moved to dead letter queue")
+		return nil
+	}
 
-		if *attacker.CorporationID == victimCorporationID {
-			return true
-		}
-	LoopEnd:
+	// Retries back off so that a short ESI outage does not use up the retry budget of every killmail in flight
+	delay := retryDelay(s.config.KillmailRetryDelay, payload.Attempts)
+	next := time.Now().Add(delay)
+
+	_, err = s.redis.ZAdd(ctx, retryQueue(lane), &redis.Z{Score: float64(next.Unix()), Member: string(data)}).Result()
+	if err != nil {
+		return errors.Wrap(err, "failed to schedule message for retry")
 	}
 
-	return false
+	entry.WithField("delay", delay).Info("message scheduled for retry")
+
+	return nil
 
 }
 
-func (s *service) calcIsNPC(ctx context.Context, killmail *neo.Killmail) bool {
+// maxRetryDelay caps the backoff between attempts of a failed killmail
+const maxRetryDelay = time.Minute * 30
 
-	if killmail.Victim == nil {
-		return false
-	}
+// retryDelay doubles the base delay for every attempt that has been made
+func retryDelay(base time.Duration, attempts uint) time.Duration {
 
-	if killmail.Attackers == nil {
-		return false
+	delay := base
+	for i := uint(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
 	}
 
-	for _, attacker := range killmail.Attackers {
-		if attacker.CorporationID == nil {
-			continue
-		}
-
-		if *attacker.CorporationID >= 98000000 {
-			return false
-		}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
 	}
 
-	return true
+	return delay
 
 }
 
-func (s *service) calcIsSolo(ctx context.Context, killmail *neo.Killmail) bool {
+// retryQueue returns the sorted set that holds the scheduled retries of a lane
+func retryQueue(lane neo.Lane) string {
+	return fmt.Sprintf(neo.QUEUES_KILLMAIL_RETRY, lane)
+}
 
-	if killmail.Victim == nil {
-		return false
-	}
+// requeueDueRetries moves messages whose retry is due from the retry sets back onto their lanes
+func (s *service) requeueDueRetries(ctx context.Context) (int, error) {
 
-	if killmail.Attackers == nil {
-		return false
-	}
+	max := strconv.FormatInt(time.Now().Unix(), 10)
 
-	if len(killmail.Attackers) > 1 {
-		return false
-	}
+	requeued := 0
+	for _, lane := range neo.AllLanes {
+		key := retryQueue(lane)
+		due, err := s.redis.ZRangeByScoreWithScores(ctx, key, &redis.ZRangeBy{Min: "-inf", Max: max}).Result()
+		if err != nil {
+			return requeued, errors.Wrap(err, "failed to fetch due killmail retries")
+		}
+
+		for _, retry := range due {
+			member, ok := retry.Member.(string)
+			if !ok {
+				continue
+			}
 
-	attacker := killmail.Attackers[0]
+			// Only the importer that removes the message from the set requeues it
+			removed, err := s.redis.ZRem(ctx, key, member).Result()
+			if err != nil {
+				return requeued, errors.Wrap(err, "failed to remove killmail retry")
+			}
+
+			if removed == 0 {
+				continue
+			}
 
-	if attacker.CorporationID == nil {
-		return false
+			err = s.queue.Publish(ctx, lane.Queue(), []byte(member))
+			if err != nil {
+				// Put the retry back so that the next pass picks it up again
+				retry := retry
+				zerr := s.redis.ZAdd(ctx, key, &retry).Err()
+				if zerr != nil {
+					s.logger.WithContext(ctx).WithError(zerr).WithField("member", member).Error("failed to restore killmail retry")
+				}
+				return requeued, errors.Wrap(err, "failed to requeue killmail retry")
+			}
+
+			requeued++
+		}
 	}
 
-	return *attacker.CorporationID >= 98000000
+	return requeued, nil
 
 }
 
@@ -564,6 +565,48 @@ var fittedFlags = map[uint64]bool{
 	164: true, 165: true, 166: true, 167: true, 168: true, 169: true, 170: true, 171: true,
 }
 
+// valuate prices the victims ship and items as of the time of the killmail and sets the
+// destroyed, dropped, fitted and total values of the killmail
+func (s *service) valuate(killmail *neo.Killmail) {
+
+	date := killmail.KillmailTime
+	shipValue := s.market.FetchTypePrice(killmail.Victim.ShipTypeID, date)
+	killmail.Victim.ShipValue = shipValue
+
+	destroyedValue := float64(0)
+	droppedValue := float64(0)
+
+	for _, item := range killmail.Victim.Items {
+		items := append([]*neo.KillmailItem{item}, item.Items...)
+		for _, item := range items {
+			itemValue := float64(0)
+			if item.Singleton != 2 {
+				itemValue = s.market.FetchTypePrice(item.ItemTypeID, date)
+			} else {
+				itemValue = 0.01
+			}
+
+			item.ItemValue = itemValue
+			if item.QuantityDestroyed != nil && *item.QuantityDestroyed > 0 {
+				destroyedValue += item.ItemValue * float64(*item.QuantityDestroyed)
+			}
+			if item.QuantityDropped != nil && *item.QuantityDropped > 0 {
+				droppedValue += item.ItemValue * float64(*item.QuantityDropped)
+			}
+		}
+	}
+
+	fittedValue := s.calculatedFittedValue(killmail.Victim.Items)
+	fittedValue += shipValue
+	destroyedValue += shipValue
+
+	killmail.DestroyedValue = destroyedValue
+	killmail.DroppedValue = droppedValue
+	killmail.FittedValue = fittedValue
+	killmail.TotalValue = droppedValue + destroyedValue
+
+}
+
 func (s *service) calculatedFittedValue(items []*neo.KillmailItem) float64 {
 
 	total := float64(0)
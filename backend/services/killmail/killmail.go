@@ -226,254 +226,26 @@ func (s *service) CacheKillmailSlice(ctx context.Context, key string, killmails
 
 }
 
-func (s *service) KillmailsByCharacterID(ctx context.Context, id uint64, page uint, additionalOperators ...*neo.Operator) ([]*neo.Killmail, error) {
-
-	operators := []*neo.Operator{
-		neo.NewLimitOperator(neo.DEFAULT_PAGE_SIZE),
-		neo.NewSkipOperator(int64((neo.DEFAULT_PAGE_SIZE * int(page)) - neo.DEFAULT_PAGE_SIZE)),
-		neo.NewOrderOperator("killmailTime", neo.SortDesc),
-		neo.NewOrOperator(
-			neo.NewEqualOperator("victim.characterID", id),
-			neo.NewEqualOperator("attackers.characterID", id),
-		),
-	}
-
-	if len(additionalOperators) > 0 {
-		operators = append(operators, additionalOperators...)
-	}
-
-	opsMarshaled, err := json.Marshal(operators)
-	if err != nil {
-		return nil, err
-	}
-
-	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY, format.Values{
-		"type": "characters",
-		"id":   id,
-		"page": page,
-		"ops":  fmt.Sprintf("%x", sha256.Sum256(opsMarshaled)),
-	})
-
-	entry := s.logger.WithFields(logrus.Fields{
-		"key":   key,
-		"class": "KillmailsByCharacterID",
-	})
-	entry.Info("checking cache")
-
-	killmails, err := s.KillmailsFromCache(ctx, key)
-	if err != nil {
-		entry.WithError(err).Error("failed to check cache")
-		return nil, err
-	}
-
-	if len(killmails) > 0 {
-		entry.Info("cache hit. returning results")
-		return killmails, nil
-	}
-
-	entry.Info("cache miss, fetch results from db")
-
-	killmails, err = s.killmails.Killmails(ctx, operators...)
-	if err != nil {
-		return nil, err
-	}
-
-	entry = entry.WithField("count", len(killmails))
-	entry.Info("killmails retrieve, caching results")
-
-	err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
-
-	entry.Info("return killmails")
-
-	return killmails, err
+// maxConnectionSize caps the number of killmails on a single page of a killmail connection
+const maxConnectionSize = 100
 
+func (s *service) KillmailsByCharacterID(ctx context.Context, id uint64, args neo.ConnectionArgs, additionalOperators ...*neo.Operator) (*neo.KillmailConnection, error) {
+	return s.killmailConnection(ctx, "KillmailsByCharacterID", "characters", id, args, append([]*neo.Operator{characterOperator(id)}, additionalOperators...)...)
 }
 
-func (s *service) KillmailsByCorporationID(ctx context.Context, id uint, page uint, additionalOperators ...*neo.Operator) ([]*neo.Killmail, error) {
-
-	operators := []*neo.Operator{
-		neo.NewLimitOperator(neo.DEFAULT_PAGE_SIZE),
-		neo.NewSkipOperator(int64((neo.DEFAULT_PAGE_SIZE * int(page)) - neo.DEFAULT_PAGE_SIZE)),
-		neo.NewOrderOperator("id", neo.SortDesc),
-		neo.NewOrOperator(
-			neo.NewEqualOperator("victim.corporationID", id),
-			neo.NewEqualOperator("attackers.corporationID", id),
-		),
-	}
-
-	if len(additionalOperators) > 0 {
-		operators = append(operators, additionalOperators...)
-	}
-
-	opsMarshaled, err := json.Marshal(operators)
-	if err != nil {
-		return nil, err
-	}
-
-	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY, format.Values{
-		"type": "corporations",
-		"id":   id,
-		"page": page,
-		"ops":  fmt.Sprintf("%x", sha256.Sum256(opsMarshaled)),
-	})
-
-	entry := s.logger.WithFields(logrus.Fields{
-		"key":   key,
-		"class": "KillmailsByCorporationID",
-	})
-	entry.Info("checking cache")
-
-	killmails, err := s.KillmailsFromCache(ctx, key)
-	if err != nil {
-		entry.WithError(err).Error("failed to check cache")
-		return nil, err
-	}
-
-	if len(killmails) > 0 {
-		entry.Info("cache hit. returning results")
-		return killmails, nil
-	}
-	entry.Info("cache miss, fetch results from db")
-
-	killmails, err = s.killmails.Killmails(ctx, operators...)
-	if err != nil {
-		return nil, err
-	}
-
-	entry = entry.WithField("count", len(killmails))
-	entry.Info("killmails retrieve, caching results")
-
-	err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
-	if err != nil {
-		entry.WithError(err).Error("failed to cache results")
-	}
-
-	entry.Info("return killmails")
-
-	return killmails, err
-
+func (s *service) KillmailsByCorporationID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOperators ...*neo.Operator) (*neo.KillmailConnection, error) {
+	return s.killmailConnection(ctx, "KillmailsByCorporationID", "corporations", id, args, append([]*neo.Operator{corporationOperator(id)}, additionalOperators...)...)
 }
 
-func (s *service) KillmailsByAllianceID(ctx context.Context, id uint, page uint, additionalOperators ...*neo.Operator) ([]*neo.Killmail, error) {
-
-	operators := []*neo.Operator{
-		neo.NewLimitOperator(neo.DEFAULT_PAGE_SIZE),
-		neo.NewSkipOperator(int64((neo.DEFAULT_PAGE_SIZE * int(page)) - neo.DEFAULT_PAGE_SIZE)),
-		neo.NewOrderOperator("id", neo.SortDesc),
-		neo.NewOrOperator(
-			neo.NewEqualOperator("victim.allianceID", id),
-			neo.NewEqualOperator("attackers.allianceID", id),
-		),
-	}
-
-	if len(additionalOperators) > 0 {
-		operators = append(operators, additionalOperators...)
-	}
-
-	opsMarshaled, err := json.Marshal(operators)
-	if err != nil {
-		return nil, err
-	}
-
-	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY, format.Values{
-		"type": "alliances",
-		"id":   id,
-		"page": page,
-		"ops":  fmt.Sprintf("%x", sha256.Sum256(opsMarshaled)),
-	})
-
-	entry := s.logger.WithFields(logrus.Fields{
-		"key":   key,
-		"class": "KillmailsByAllianceID",
-	})
-	entry.Info("checking cache")
-
-	killmails, err := s.KillmailsFromCache(ctx, key)
-	if err != nil {
-		entry.WithError(err).Error("failed to check cache")
-		return nil, err
-	}
-
-	if len(killmails) > 0 {
-		entry.Info("cache hit. returning results")
-		return killmails, nil
-	}
-	entry.Info("cache miss, fetch results from db")
-
-	killmails, err = s.killmails.Killmails(ctx, operators...)
-	if err != nil {
-		return nil, err
-	}
-
-	entry = entry.WithField("count", len(killmails))
-	entry.Info("killmails retrieve, caching results")
-
-	err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
-	if err != nil {
-		entry.WithError(err).Error("failed to cache results")
-	}
-
-	entry.Info("return killmails")
-
-	return killmails, err
-
+func (s *service) KillmailsByAllianceID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOperators ...*neo.Operator) (*neo.KillmailConnection, error) {
+	return s.killmailConnection(ctx, "KillmailsByAllianceID", "alliances", id, args, append([]*neo.Operator{allianceOperator(id)}, additionalOperators...)...)
 }
 
-func (s *service) KillmailsByShipID(ctx context.Context, id uint, page uint, additionalOperators ...*neo.Operator) ([]*neo.Killmail, error) {
-
-	operators := []*neo.Operator{
-		neo.NewLimitOperator(neo.DEFAULT_PAGE_SIZE),
-		neo.NewSkipOperator(int64((neo.DEFAULT_PAGE_SIZE * int(page)) - neo.DEFAULT_PAGE_SIZE)),
-		neo.NewOrderOperator("id", neo.SortDesc),
-		neo.NewOrOperator(
-			neo.NewEqualOperator("victim.shipTypeID", id),
-			neo.NewEqualOperator("attackers.shipTypeID", id),
-		),
-	}
-
-	if len(additionalOperators) > 0 {
-		operators = append(operators, additionalOperators...)
-	}
-
-	opsMarshaled, err := json.Marshal(operators)
-	if err != nil {
-		return nil, err
-	}
-
-	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY, format.Values{
-		"type": "ships",
-		"id":   id,
-		"page": page,
-		"ops":  fmt.Sprintf("%x", sha256.Sum256(opsMarshaled)),
-	})
-
-	entry := s.logger.WithFields(logrus.Fields{
-		"key":   key,
-		"class": "KillmailsByShipID",
-	})
-	entry.Info("checking cache")
-
-	killmails, err := s.KillmailsFromCache(ctx, key)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(killmails) > 0 {
-		return killmails, nil
-	}
-
-	killmails, err = s.killmails.Killmails(ctx, operators...)
-	if err != nil {
-		return nil, err
-	}
-
-	err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
-
-	return killmails, err
-
+func (s *service) KillmailsByShipID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOperators ...*neo.Operator) (*neo.KillmailConnection, error) {
+	return s.killmailConnection(ctx, "KillmailsByShipID", "ships", id, args, append([]*neo.Operator{shipOperator(id)}, additionalOperators...)...)
 }
 
-func (s *service) KillmailsByShipGroupID(ctx context.Context, id uint, page uint, additionalOperators ...*neo.Operator) ([]*neo.Killmail, error) {
+func (s *service) KillmailsByShipGroupID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOperators ...*neo.Operator) (*neo.KillmailConnection, error) {
 
 	allowed := tools.IsGroupAllowed(id)
 	if !allowed {
@@ -481,97 +253,83 @@ func (s *service) KillmailsByShipGroupID(ctx context.Context, id uint, page uint
 	}
 
 	operators := []*neo.Operator{
-		neo.NewLimitOperator(neo.DEFAULT_PAGE_SIZE),
-		neo.NewSkipOperator(int64((neo.DEFAULT_PAGE_SIZE * int(page)) - neo.DEFAULT_PAGE_SIZE)),
-		neo.NewOrderOperator("id", neo.SortDesc),
 		neo.NewOrOperator(
 			neo.NewEqualOperator("victim.shipGroupID", id),
 			neo.NewEqualOperator("attackers.shipGroupID", id),
 		),
 	}
 
-	if len(additionalOperators) > 0 {
-		operators = append(operators, additionalOperators...)
-	}
+	return s.killmailConnection(ctx, "KillmailsByShipGroupID", "shipGroup", id, args, append(operators, additionalOperators...)...)
 
-	opsMarshaled, err := json.Marshal(operators)
-	if err != nil {
-		return nil, err
-	}
+}
 
-	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY, format.Values{
-		"type": "shipGroup",
-		"id":   id,
-		"page": page,
-		"ops":  fmt.Sprintf("%x", sha256.Sum256(opsMarshaled)),
-	})
+func (s *service) KillmailsBySystemID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOperators ...*neo.Operator) (*neo.KillmailConnection, error) {
+	return s.killmailConnection(ctx, "KillmailsBySystemID", "systems", id, args, append([]*neo.Operator{systemOperator(id)}, additionalOperators...)...)
+}
 
-	entry := s.logger.WithFields(logrus.Fields{
-		"key":   key,
-		"class": "KillmailsByShipGroupID",
-	})
-	entry.Info("checking cache")
+func (s *service) KillmailsByConstellationID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOperators ...*neo.Operator) (*neo.KillmailConnection, error) {
+	return s.killmailConnection(ctx, "KillmailsByConstellationID", "constellation", id, args, append([]*neo.Operator{neo.NewEqualOperator("constellationID", id)}, additionalOperators...)...)
+}
 
-	killmails, err := s.KillmailsFromCache(ctx, key)
-	if err != nil {
-		entry.WithError(err).Error("failed to check cache")
-		return nil, err
-	}
+func (s *service) KillmailsByRegionID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOperators ...*neo.Operator) (*neo.KillmailConnection, error) {
+	return s.killmailConnection(ctx, "KillmailsByRegionID", "region", id, args, append([]*neo.Operator{neo.NewEqualOperator("regionID", id)}, additionalOperators...)...)
+}
 
-	if len(killmails) > 0 {
-		entry.Info("cache hit. returning results")
-		return killmails, nil
+// killmailConnection returns a page of the killmails matching the operators, newest first. Pages are seeked from
+// the (killmailTime, id) of the cursor instead of skipped over, so deep pages are as cheap to fetch as the first one
+func (s *service) killmailConnection(ctx context.Context, class, entityType string, id interface{}, args neo.ConnectionArgs, operators ...*neo.Operator) (*neo.KillmailConnection, error) {
+
+	backward := args.Backward()
+
+	size := args.First
+	if backward {
+		size = args.Last
+	}
+	if size == 0 {
+		size = neo.DEFAULT_PAGE_SIZE
+	}
+	if size < 0 || size > maxConnectionSize {
+		return nil, errors.Errorf("page size must be between 1 and %d", maxConnectionSize)
 	}
-	entry.Info("cache miss, fetch results from db")
 
-	killmails, err = s.killmails.Killmails(ctx, operators...)
+	total, err := s.countKillmailsByEntity(ctx, class, entityType, id, operators...)
 	if err != nil {
-		entry.WithError(err).Error("failed to fetch results from db")
 		return nil, err
 	}
 
-	entry = entry.WithField("count", len(killmails))
-	entry.Info("killmails retrieve, caching results")
-
-	err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
-	if err != nil {
-		entry.WithError(err).Error("failed to cache results")
+	sort := neo.SortDesc
+	if backward {
+		sort = neo.SortAsc
 	}
 
-	entry.Info("return killmails")
-
-	return killmails, err
-
-}
-
-func (s *service) KillmailsBySystemID(ctx context.Context, id uint, page uint, additionalOperators ...*neo.Operator) ([]*neo.Killmail, error) {
-
-	operators := []*neo.Operator{
-		neo.NewLimitOperator(neo.DEFAULT_PAGE_SIZE),
-		neo.NewSkipOperator(int64((neo.DEFAULT_PAGE_SIZE * int(page)) - neo.DEFAULT_PAGE_SIZE)),
-		neo.NewOrderOperator("id", neo.SortDesc),
-		neo.NewEqualOperator("solarSystemID", id),
+	pageOperators := append([]*neo.Operator{}, operators...)
+	if backward && args.Before != nil {
+		pageOperators = append(pageOperators, args.Before.Newer())
+	} else if !backward && args.After != nil {
+		pageOperators = append(pageOperators, args.After.Older())
 	}
 
-	if len(additionalOperators) > 0 {
-		operators = append(operators, additionalOperators...)
-	}
+	// One killmail more than the page holds is fetched to tell whether there is another page
+	pageOperators = append(pageOperators,
+		neo.NewOrderOperator("killmailTime", sort),
+		neo.NewOrderOperator("id", sort),
+		neo.NewLimitOperator(int64(size+1)),
+	)
 
-	opsMarshaled, err := json.Marshal(operators)
+	opsMarshaled, err := json.Marshal(pageOperators)
 	if err != nil {
 		return nil, err
 	}
 
 	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY, format.Values{
-		"type": "systems",
+		"type": entityType,
 		"id":   id,
-		"page": page,
 		"ops":  fmt.Sprintf("%x", sha256.Sum256(opsMarshaled)),
 	})
 
 	entry := s.logger.WithFields(logrus.Fields{
 		"key":   key,
-		"class": "KillmailsBySystemID",
+		"class": class,
 	})
 	entry.Info("checking cache")
 
@@ -581,149 +339,106 @@ func (s *service) KillmailsBySystemID(ctx context.Context, id uint, page uint, a
 		return nil, err
 	}
 
-	if len(killmails) > 0 {
-		entry.Info("cache hit. returning results")
-		return killmails, nil
-	}
-	entry.Info("cache miss, fetch results from db")
+	if len(killmails) == 0 {
+		entry.Info("cache miss, fetch results from db")
 
-	killmails, err = s.killmails.Killmails(ctx, operators...)
-	if err != nil {
-		entry.WithError(err).Error("failed to fetch results from db")
-		return nil, err
-	}
-
-	entry = entry.WithField("count", len(killmails))
-	entry.Info("killmails retrieve, caching results")
-
-	err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
-	if err != nil {
-		entry.WithError(err).Error("failed to cache results")
-	}
-
-	entry.Info("return killmails")
-
-	return killmails, err
-
-}
+		killmails, err = s.killmails.Killmails(ctx, pageOperators...)
+		if err != nil {
+			entry.WithError(err).Error("failed to fetch results from db")
+			return nil, err
+		}
 
-func (s *service) KillmailsByConstellationID(ctx context.Context, id uint, page uint, additionalOperators ...*neo.Operator) ([]*neo.Killmail, error) {
+		entry = entry.WithField("count", len(killmails))
+		entry.Info("killmails retrieve, caching results")
 
-	operators := []*neo.Operator{
-		neo.NewLimitOperator(neo.DEFAULT_PAGE_SIZE),
-		neo.NewSkipOperator(int64((neo.DEFAULT_PAGE_SIZE * int(page)) - neo.DEFAULT_PAGE_SIZE)),
-		neo.NewOrderOperator("id", neo.SortDesc),
-		neo.NewEqualOperator("constellationID", id),
+		err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
+		if err != nil {
+			entry.WithError(err).Error("failed to cache results")
+		}
 	}
 
-	if len(additionalOperators) > 0 {
-		operators = append(operators, additionalOperators...)
+	more := len(killmails) > size
+	if more {
+		killmails = killmails[:size]
 	}
 
-	opsMarshaled, err := json.Marshal(operators)
-	if err != nil {
-		return nil, err
+	if backward {
+		for i, j := 0, len(killmails)-1; i < j; i, j = i+1, j-1 {
+			killmails[i], killmails[j] = killmails[j], killmails[i]
+		}
 	}
 
-	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY, format.Values{
-		"type": "constellation",
-		"id":   id,
-		"page": page,
-		"ops":  fmt.Sprintf("%x", sha256.Sum256(opsMarshaled)),
-	})
-
-	entry := s.logger.WithFields(logrus.Fields{
-		"key":   key,
-		"class": "KillmailsByConstellationID",
-	})
-	entry.Info("checking cache")
-
-	killmails, err := s.KillmailsFromCache(ctx, key)
-	if err != nil {
-		entry.WithError(err).Error("failed to check cache")
-		return nil, err
+	connection := &neo.KillmailConnection{
+		Edges:      make([]*neo.KillmailEdge, 0, len(killmails)),
+		PageInfo:   &neo.PageInfo{},
+		TotalCount: total,
 	}
 
-	if len(killmails) > 0 {
-		entry.Info("cache hit. returning results")
-		return killmails, nil
+	for _, killmail := range killmails {
+		connection.Edges = append(connection.Edges, &neo.KillmailEdge{
+			Cursor: neo.NewKillmailCursor(killmail).String(),
+			Node:   killmail,
+		})
 	}
-	entry.Info("cache miss, fetch results from db")
 
-	killmails, err = s.killmails.Killmails(ctx, operators...)
-	if err != nil {
-		return nil, err
+	if len(connection.Edges) > 0 {
+		connection.PageInfo.StartCursor = &connection.Edges[0].Cursor
+		connection.PageInfo.EndCursor = &connection.Edges[len(connection.Edges)-1].Cursor
 	}
 
-	if err != nil {
-		return nil, err
+	if backward {
+		connection.PageInfo.HasPreviousPage = more
+		connection.PageInfo.HasNextPage = args.Before != nil
+	} else {
+		connection.PageInfo.HasNextPage = more
+		connection.PageInfo.HasPreviousPage = args.After != nil
 	}
 
-	err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
+	entry.Info("return killmails")
 
-	return killmails, err
+	return connection, nil
 
 }
 
-func (s *service) KillmailsByRegionID(ctx context.Context, id uint, page uint, additionalOperators ...*neo.Operator) ([]*neo.Killmail, error) {
-
-	operators := []*neo.Operator{
-		neo.NewLimitOperator(neo.DEFAULT_PAGE_SIZE),
-		neo.NewSkipOperator(int64((neo.DEFAULT_PAGE_SIZE * int(page)) - neo.DEFAULT_PAGE_SIZE)),
-		neo.NewOrderOperator("id", neo.SortDesc),
-		neo.NewEqualOperator("regionID", id),
-	}
-
-	if len(additionalOperators) > 0 {
-		operators = append(operators, additionalOperators...)
-	}
+// countKillmailsByEntity returns the number of killmails matching the operators, caching the count for a few
+// minutes since counting the history of a large entity is expensive
+func (s *service) countKillmailsByEntity(ctx context.Context, class, entityType string, id interface{}, operators ...*neo.Operator) (int64, error) {
 
 	opsMarshaled, err := json.Marshal(operators)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY, format.Values{
-		"type": "region",
+	var key = format.Formatm(neo.REDIS_KILLMAILS_BY_ENTITY_COUNT, format.Values{
+		"type": entityType,
 		"id":   id,
-		"page": page,
 		"ops":  fmt.Sprintf("%x", sha256.Sum256(opsMarshaled)),
 	})
 
 	entry := s.logger.WithFields(logrus.Fields{
 		"key":   key,
-		"class": "KillmailsByRegionID",
+		"class": class,
 	})
-	entry.Info("checking cache")
 
-	killmails, err := s.KillmailsFromCache(ctx, key)
-	if err != nil {
-		entry.WithError(err).Error("failed to check cache")
-		return nil, err
+	count, err := s.redis.Get(ctx, key).Int64()
+	if err == nil {
+		return count, nil
 	}
-
-	if len(killmails) > 0 {
-		entry.Info("cache hit. returning results")
-		return killmails, nil
+	if err.Error() != neo.ErrRedisNil.Error() {
+		entry.WithError(err).Error("failed to fetch count from cache")
 	}
-	entry.Info("cache miss, fetch results from db")
 
-	killmails, err = s.killmails.Killmails(ctx, operators...)
+	count, err = s.killmails.CountKillmails(ctx, operators...)
 	if err != nil {
-		entry.WithError(err).Error("failed to fetch results from db")
-		return nil, err
+		entry.WithError(err).Error("failed to count killmails")
+		return 0, err
 	}
 
-	entry = entry.WithField("count", len(killmails))
-	entry.Info("killmails retrieve, caching results")
-
-	err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
+	_, err = s.redis.Set(ctx, key, count, time.Minute*5).Result()
 	if err != nil {
-		entry.WithError(err).Error("failed to cache results")
+		entry.WithError(err).Error("failed to cache count")
 	}
 
-	entry.Info("return killmails")
-
-	return killmails, err
+	return count, nil
 
 }
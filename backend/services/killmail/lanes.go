@@ -0,0 +1,114 @@
+package killmail
+
+import (
+	"context"
+	"sort"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/services/queue"
+	"github.com/sirupsen/logrus"
+)
+
+type laneMessage struct {
+	lane neo.Lane
+	*queue.Message
+}
+
+// lanes returns the processing lanes ordered by their configured weight, heaviest first
+func (s *service) lanes() []neo.Lane {
+
+	lanes := make([]neo.Lane, len(neo.AllLanes))
+	copy(lanes, neo.AllLanes)
+
+	sort.SliceStable(lanes, func(i, j int) bool {
+		return s.laneWeight(lanes[i]) > s.laneWeight(lanes[j])
+	})
+
+	return lanes
+
+}
+
+func (s *service) laneWeight(lane neo.Lane) int {
+	weight, ok := s.config.KillmailLaneWeights[lane.String()]
+	if !ok || weight < 1 {
+		return 1
+	}
+
+	return weight
+}
+
+// consumeLanes fills a batch of up to count messages from the processing lanes. Each lane is first offered a share
+// of the batch proportional to its weight, then any capacity left over by empty lanes is offered to the remaining lanes
+// in order of weight. If every lane is empty, this blocks on the live lane so that live killmails are picked up as soon
+// as they arrive
+func (s *service) consumeLanes(ctx context.Context, count int64) ([]*laneMessage, error) {
+
+	lanes := s.lanes()
+
+	total := 0
+	for _, lane := range lanes {
+		total += s.laneWeight(lane)
+	}
+
+	messages := make([]*laneMessage, 0, count)
+	remaining := count
+
+	for _, lane := range lanes {
+		if remaining <= 0 {
+			break
+		}
+
+		share := (count*int64(s.laneWeight(lane)) + int64(total) - 1) / int64(total)
+		if share > remaining {
+			share = remaining
+		}
+
+		polled, err := s.queue.Poll(ctx, lane.Queue(), share)
+		if err != nil {
+			return messages, err
+		}
+
+		messages = append(messages, wrapLaneMessages(lane, polled)...)
+		remaining -= int64(len(polled))
+	}
+
+	for _, lane := range lanes {
+		if remaining <= 0 {
+			break
+		}
+
+		polled, err := s.queue.Poll(ctx, lane.Queue(), remaining)
+		if err != nil {
+			return messages, err
+		}
+
+		messages = append(messages, wrapLaneMessages(lane, polled)...)
+		remaining -= int64(len(polled))
+	}
+
+	if len(messages) > 0 {
+		s.logger.WithContext(ctx).WithFields(logrus.Fields{
+			"count": len(messages),
+		}).Debug("consumed messages from processing lanes")
+		return messages, nil
+	}
+
+	live, err := s.queue.Consume(ctx, neo.LaneLive.Queue(), count)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapLaneMessages(neo.LaneLive, live), nil
+
+}
+
+func wrapLaneMessages(lane neo.Lane, messages []*queue.Message) []*laneMessage {
+
+	wrapped := make([]*laneMessage, 0, len(messages))
+	for _, message := range messages {
+		wrapped = append(wrapped, &laneMessage{lane: lane, Message: message})
+	}
+
+	return wrapped
+
+}
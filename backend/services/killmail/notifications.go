@@ -0,0 +1,118 @@
+package killmail
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// notificationRuleTTL is how long enabled notification rules are kept in memory before they are reloaded,
+// so that rules are not fetched from the database for every imported killmail
+const notificationRuleTTL = time.Minute
+
+type ruleCache struct {
+	mx     sync.Mutex
+	rules  []*neo.NotificationRule
+	expiry time.Time
+}
+
+func (s *service) enabledNotificationRules(ctx context.Context) ([]*neo.NotificationRule, error) {
+
+	s.notificationRules.mx.Lock()
+	defer s.notificationRules.mx.Unlock()
+
+	if time.Now().Before(s.notificationRules.expiry) {
+		return s.notificationRules.rules, nil
+	}
+
+	rules, err := s.rules.NotificationRules(ctx, neo.NewEqualOperator("enabled", true))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch notification rules")
+	}
+
+	s.notificationRules.rules = rules
+	s.notificationRules.expiry = time.Now().Add(notificationRuleTTL)
+
+	return rules, nil
+
+}
+
+// destinationKey identifies a destination the way the unique index on notification deliveries does
+type destinationKey struct {
+	Type neo.NotificationDestinationType
+	URL  string
+}
+
+// dispatchNotifications evaluates the enabled notification rules against the killmail and queues a single delivery
+// for every destination with at least one matching rule. Destinations the killmail has already been queued for,
+// i.e. when the killmail is imported a second time, are skipped
+func (s *service) dispatchNotifications(ctx context.Context, entry *logrus.Entry, killmail *neo.Killmail) {
+
+	rules, err := s.enabledNotificationRules(ctx)
+	if err != nil {
+		entry.WithError(err).Error("failed to load notification rules")
+		return
+	}
+
+	// Deliveries are grouped on the same fields as the killmailID_destination index. Rules that share a url but sign
+	// with different secrets share a delivery, which is signed with the secret of the first of them
+	deliveries := make(map[destinationKey]*neo.NotificationDelivery)
+	order := make([]destinationKey, 0)
+	for _, rule := range rules {
+		if !rule.Criteria.Match(killmail) {
+			continue
+		}
+
+		key := destinationKey{rule.Destination.Type, rule.Destination.URL}
+		delivery, ok := deliveries[key]
+		if !ok {
+			delivery = &neo.NotificationDelivery{
+				KillmailID:  killmail.ID,
+				Hash:        killmail.Hash,
+				RuleIDs:     make([]string, 0),
+				Destination: rule.Destination,
+				Status:      neo.NotificationDeliveryPending,
+			}
+			deliveries[key] = delivery
+			order = append(order, key)
+		}
+
+		delivery.RuleIDs = append(delivery.RuleIDs, rule.ID)
+	}
+
+	for _, key := range order {
+		delivery := deliveries[key]
+		entry := entry.WithField("destination", key.Type)
+
+		created, err := s.deliveries.CreateNotificationDelivery(ctx, delivery)
+		if err != nil {
+			entry.WithError(err).Error("failed to record notification delivery")
+			continue
+		}
+
+		if !created {
+			entry.Debug("killmail has already been queued for destination, skipping")
+			continue
+		}
+
+		// Only the id is queued, the consumer loads the delivery itself. This keeps the destination secret
+		// out of the queue
+		payload, err := json.Marshal(neo.NotificationDelivery{ID: delivery.ID})
+		if err != nil {
+			entry.WithError(err).Error("failed to marshal notification delivery")
+			continue
+		}
+
+		err = s.queue.Publish(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION, payload)
+		if err != nil {
+			// The delivery stays pending and is requeued by the stale delivery sweep of the notification consumer
+			entry.WithError(err).Error("failed to publish notification delivery")
+		}
+	}
+
+}
@@ -0,0 +1,55 @@
+package killmail
+
+import "github.com/eveisesi/neo"
+
+// The operators below match killmails that an entity was involved in on either side and are shared between the
+// KillmailsBy*ID queries and the watchlist
+
+func characterOperator(id uint64) *neo.Operator {
+	return neo.NewOrOperator(
+		neo.NewEqualOperator("victim.characterID", id),
+		neo.NewEqualOperator("attackers.characterID", id),
+	)
+}
+
+func corporationOperator(id uint) *neo.Operator {
+	return neo.NewOrOperator(
+		neo.NewEqualOperator("victim.corporationID", id),
+		neo.NewEqualOperator("attackers.corporationID", id),
+	)
+}
+
+func allianceOperator(id uint) *neo.Operator {
+	return neo.NewOrOperator(
+		neo.NewEqualOperator("victim.allianceID", id),
+		neo.NewEqualOperator("attackers.allianceID", id),
+	)
+}
+
+func shipOperator(id uint) *neo.Operator {
+	return neo.NewOrOperator(
+		neo.NewEqualOperator("victim.shipTypeID", id),
+		neo.NewEqualOperator("attackers.shipTypeID", id),
+	)
+}
+
+func systemOperator(id uint) *neo.Operator {
+	return neo.NewEqualOperator("solarSystemID", id)
+}
+
+// watchOperator returns the operator matching the killmails of a watched entity
+func watchOperator(entity neo.WatchEntity, id uint64) *neo.Operator {
+	switch entity {
+	case neo.WatchEntityCharacter:
+		return characterOperator(id)
+	case neo.WatchEntityCorporation:
+		return corporationOperator(uint(id))
+	case neo.WatchEntityAlliance:
+		return allianceOperator(uint(id))
+	case neo.WatchEntitySystem:
+		return systemOperator(uint(id))
+	case neo.WatchEntityShip:
+		return shipOperator(uint(id))
+	}
+	return nil
+}
@@ -1,148 +1,110 @@
 package killmail
 
-// func (s *service) RecalculatorDispatcher(limit, trigger int64, after uint) {
-
-// 	for {
-
-// 		count, err := s.redis.ZCount(neo.QUEUES_KILLMAIL_RECALCULATE, "-inf", "+inf").Result()
-// 		if err != nil {
-// 			s.logger.WithError(err).Error("unable to determine count of recalculation message queue")
-// 			time.Sleep(time.Second * 2)
-// 			continue
-// 		}
-
-// 		if count >= trigger {
-// 			time.Sleep(time.Second * 10)
-// 			continue
-// 		}
-
-// 		s.logger.Info("fetching killmail to recalculate")
-
-// 		killmails, err := s.killmails.Recalculable(context.Background(), int(limit), after)
-// 		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
-// 			s.logger.WithError(err).Error("failed to fetch killmails")
-// 			return
-// 		}
-
-// 		if len(killmails) == 0 {
-// 			s.logger.Info("no killmails returned")
-// 			return
-// 		}
-
-// 		s.logger.WithField("killmails", len(killmails)).Info("killmails retrieved successfully")
-
-// 		after = killmails[len(killmails)-1].ID
-
-// 		for _, killmail := range killmails {
-
-// 			msg := neo.Message{
-// 				ID:   killmail.ID,
-// 				Hash: killmail.Hash,
-// 			}
-
-// 			payload, err := json.Marshal(msg)
-// 			if err != nil {
-// 				s.logger.WithError(err).Error("failed to marshal message for recalculator queue")
-// 				continue
-// 			}
-
-// 			_, err = s.redis.ZAdd(neo.QUEUES_KILLMAIL_RECALCULATE, &redis.Z{Score: float64(killmail.ID), Member: string(payload)}).Result()
-// 			if err != nil {
-// 				s.logger.WithError(err).WithField("payload", string(payload)).Error("unable to push killmail to recalculating queue")
-// 				return
-// 			}
-// 		}
-
-// 		s.logger.WithField("after", after).Info("killmails dispatched successfully")
-// 	}
-
-// }
-
-// func (s *service) Recalculator(gLimit int64) {
-
-// 	limit := limiter.NewConcurrencyLimiter(int(gLimit))
-
-// 	attempts := 0
-// 	for {
-// 		count, err := s.redis.ZCount(neo.QUEUES_KILLMAIL_RECALCULATE, "-inf", "+inf").Result()
-// 		if err != nil {
-// 			s.logger.WithError(err).Error("unable to determine count of recalculation message queue")
-// 			time.Sleep(time.Second * 2)
-// 			continue
-// 		}
-
-// 		if count == 0 {
-// 			attempts++
-// 			if attempts >= 100 {
-// 				s.logger.Info("done with recalculation")
-// 				break
-// 			}
-// 			s.logger.WithField("count", count).Info("no messages on queue")
-// 			time.Sleep(time.Second * 10)
-// 			continue
-// 		}
-
-// 		s.logger.WithField("messages", count).Info("processing recalculable messages")
-
-// 		results, err := s.redis.ZPopMax(neo.QUEUES_KILLMAIL_RECALCULATE, 1000).Result()
-// 		if err != nil {
-// 			s.logger.WithError(err).Fatal("unable to retrieve hashes from queue")
-// 		}
-
-// 		for _, result := range results {
-// 			message := result.Member.(string)
-// 			limit.ExecuteWithTicket(func(workerID int) {
-// 				s.recalculateKillmail([]byte(message), workerID)
-// 			})
-// 		}
-
-// 	}
-
-// }
-
-// func (s *service) recalculateKillmail(message []byte, workerID int) {
-
-// 	var ctx = context.Background()
-
-// 	var payload = neo.Message{}
-
-// 	err := json.Unmarshal(message, &payload)
-// 	if err != nil {
-// 		s.logger.WithField("message", string(message)).Error("failed to unmarshal message into message struct")
-// 		return
-// 	}
-
-// 	entry := s.logger.WithFields(logrus.Fields{
-// 		"id":   payload.ID,
-// 		"hash": payload.Hash,
-// 	})
-
-// 	entry.Debugln()
-
-// 	killmail, err := s.killmails.Killmail(ctx, payload.ID)
-// 	if err != nil {
-// 		entry.WithError(err).Error("unable to retreive killmail from db")
-// 		return
-// 	}
-
-// 	killmail.Victim, err = s.victim.ByKillmailID(ctx, killmail.ID)
-// 	if err != nil {
-// 		entry.WithError(err).Error("encountered error fetching victim")
-// 		return
-// 	}
-
-// 	killmail.Victim.Items, err = s.items.ByKillmailID(ctx, killmail.ID)
-// 	if err != nil {
-// 		entry.WithError(err).Error("encountered error fetching victim items")
-// 		return
-// 	}
-
-// 	killmail.Attackers, err = s.attackers.ByKillmailID(ctx, killmail.ID)
-// 	if err != nil {
-// 		entry.WithError(err).Error("encountered error fetching attackers")
-// 		return
-// 	}
-
-// 	s.primeKillmailNodes(ctx, killmail, entry)
-
-// }
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/pkg/errors"
+)
+
+type (
+	// Revaluation holds the values of a killmail before and after it was revalued
+	Revaluation struct {
+		ID           uint
+		KillmailTime time.Time
+		Old          Valuation
+		New          Valuation
+	}
+
+	Valuation struct {
+		Destroyed float64
+		Dropped   float64
+		Fitted    float64
+		Total     float64
+	}
+)
+
+func valuationOf(killmail *neo.Killmail) Valuation {
+	return Valuation{
+		Destroyed: killmail.DestroyedValue,
+		Dropped:   killmail.DroppedValue,
+		Fitted:    killmail.FittedValue,
+		Total:     killmail.TotalValue,
+	}
+}
+
+// Recalculate re-runs valuation against every killmail matching the filter and persists any values that changed.
+// When dryRun is true nothing is persisted. report is called for every killmail whose values changed and is never
+// called concurrently. The number of killmails whose values changed is returned
+func (s *service) Recalculate(ctx context.Context, filter BackfillFilter, dryRun bool, gLimit int64, report func(*Revaluation)) (int64, error) {
+
+	var (
+		changed int64
+		mx      sync.Mutex
+	)
+
+	err := s.backfill(ctx, "recalculate", filter, gLimit, func(killmail *neo.Killmail) {
+		revaluation, err := s.recalculateKillmail(ctx, killmail, dryRun)
+		if err != nil {
+			s.logger.WithContext(ctx).WithError(err).WithField("id", killmail.ID).Error("failed to recalculate killmail")
+			return
+		}
+
+		if revaluation == nil {
+			return
+		}
+
+		mx.Lock()
+		defer mx.Unlock()
+		changed++
+		if report != nil {
+			report(revaluation)
+		}
+	})
+
+	return changed, err
+
+}
+
+// recalculateKillmail revalues a single killmail, returning nil if its values did not change
+func (s *service) recalculateKillmail(ctx context.Context, killmail *neo.Killmail, dryRun bool) (*Revaluation, error) {
+
+	txn := s.newrelic.StartTransaction("recalculateKillmail")
+	defer txn.End()
+	ctx = newrelic.NewContext(ctx, txn)
+
+	if killmail.Victim == nil {
+		txn.Ignore()
+		return nil, nil
+	}
+
+	old := valuationOf(killmail)
+	s.valuate(killmail)
+	revaluation := &Revaluation{
+		ID:           killmail.ID,
+		KillmailTime: killmail.KillmailTime,
+		Old:          old,
+		New:          valuationOf(killmail),
+	}
+
+	if revaluation.Old == revaluation.New {
+		txn.Ignore()
+		return nil, nil
+	}
+
+	if dryRun {
+		return revaluation, nil
+	}
+
+	err := s.killmails.UpdateKillmail(ctx, killmail.ID, killmail)
+	if err != nil {
+		txn.NoticeError(err)
+		return nil, errors.Wrap(err, "failed to persist recalculated killmail")
+	}
+
+	return revaluation, nil
+
+}
@@ -0,0 +1,121 @@
+package killmail
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultRelatedWindow = time.Minute * 15
+	maxRelatedWindow     = time.Hour
+	defaultRelatedRadius = neo.RelatedRadiusSystem
+)
+
+// RelatedKillmails returns the other killmails that happened within window either side of the killmail, in the same
+// solar system or constellation depending on radius. Killmails are grouped by the alliance of their victim, with the
+// most valuable group first and victims without an alliance grouped last
+func (s *service) RelatedKillmails(ctx context.Context, killmail *neo.Killmail, window time.Duration, radius neo.RelatedRadius) ([]*neo.RelatedKillmails, error) {
+
+	if window <= 0 {
+		window = defaultRelatedWindow
+	}
+
+	if radius == "" {
+		radius = defaultRelatedRadius
+	}
+
+	if window > maxRelatedWindow {
+		return nil, errors.Errorf("window must not exceed %s", maxRelatedWindow)
+	}
+
+	if !radius.IsValid() {
+		return nil, errors.Errorf("%s is not a valid radius", radius)
+	}
+
+	var key = fmt.Sprintf(neo.REDIS_RELATED_KILLMAILS, killmail.ID, radius, int(window.Minutes()))
+
+	entry := s.logger.WithFields(logrus.Fields{
+		"key":   key,
+		"class": "RelatedKillmails",
+	})
+
+	killmails, err := s.KillmailsFromCache(ctx, key)
+	if err != nil {
+		entry.WithError(err).Error("failed to check cache")
+		return nil, err
+	}
+
+	if len(killmails) == 0 {
+		from, to := killmail.KillmailTime.Add(-window), killmail.KillmailTime.Add(window)
+
+		switch radius {
+		case neo.RelatedRadiusConstellation:
+			killmails, err = s.killmails.KillmailsByConstellationAndTime(ctx, killmail.ConstellationID, from, to)
+		default:
+			killmails, err = s.killmails.KillmailsBySolarSystemAndTime(ctx, killmail.SolarSystemID, from, to)
+		}
+		if err != nil {
+			entry.WithError(err).Error("failed to fetch results from db")
+			return nil, err
+		}
+
+		err = s.CacheKillmailSlice(ctx, key, killmails, time.Minute*2)
+		if err != nil {
+			entry.WithError(err).Error("failed to cache results")
+		}
+	}
+
+	return groupByVictimAlliance(killmail.ID, killmails), nil
+
+}
+
+func groupByVictimAlliance(exclude uint, killmails []*neo.Killmail) []*neo.RelatedKillmails {
+
+	var (
+		unaligned = &neo.RelatedKillmails{Killmails: make([]*neo.Killmail, 0)}
+		groups    = make(map[uint]*neo.RelatedKillmails)
+	)
+
+	for _, killmail := range killmails {
+		if killmail.ID == exclude || killmail.Victim == nil {
+			continue
+		}
+
+		group := unaligned
+		if killmail.Victim.AllianceID != nil && *killmail.Victim.AllianceID > 0 {
+			id := *killmail.Victim.AllianceID
+			if _, ok := groups[id]; !ok {
+				groups[id] = &neo.RelatedKillmails{AllianceID: &id, Killmails: make([]*neo.Killmail, 0)}
+			}
+			group = groups[id]
+		}
+
+		group.Killmails = append(group.Killmails, killmail)
+		group.TotalValue += killmail.TotalValue
+	}
+
+	results := make([]*neo.RelatedKillmails, 0, len(groups)+1)
+	for _, group := range groups {
+		results = append(results, group)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].TotalValue == results[j].TotalValue {
+			return *results[i].AllianceID < *results[j].AllianceID
+		}
+		return results[i].TotalValue > results[j].TotalValue
+	})
+
+	if len(unaligned.Killmails) > 0 {
+		results = append(results, unaligned)
+	}
+
+	return results
+
+}
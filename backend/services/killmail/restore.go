@@ -0,0 +1,97 @@
+package killmail
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/eveisesi/neo/services/esi"
+	"github.com/korovkin/limiter"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Restore reads raw ESI killmail payloads from the backup archive for every day between from and to inclusive,
+// and runs them through the same enrichment and valuation path as the importer without calling ESI. Killmails
+// that already exist are skipped, while restored killmails are queued for the stats consumer. The number of
+// killmails restored is returned
+func (s *service) Restore(ctx context.Context, dir string, from, to time.Time, gLimit int64) (int64, error) {
+
+	if to.Before(from) {
+		return 0, errors.New("to must not be before from")
+	}
+
+	var restored int64
+
+	limit := limiter.NewConcurrencyLimiter(int(gLimit))
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+
+		entry := s.logger.WithContext(ctx).WithField("date", date.Format("2006-01-02"))
+
+		err := s.backup.Read(ctx, dir, date, func(id uint, hash string, data []byte) error {
+			limit.Execute(func() {
+				ok := s.restoreKillmail(ctx, entry, id, hash, data)
+				if ok {
+					atomic.AddInt64(&restored, 1)
+				}
+			})
+			return nil
+		})
+
+		limit.Wait()
+
+		if err != nil {
+			return atomic.LoadInt64(&restored), errors.Wrapf(err, "failed to read backup for %s", date.Format("2006-01-02"))
+		}
+
+		entry.WithField("restored", atomic.LoadInt64(&restored)).Info("finished restoring date")
+	}
+
+	return restored, nil
+
+}
+
+// restoreKillmail imports a single backed up killmail, returning true if a new killmail was created
+func (s *service) restoreKillmail(ctx context.Context, entry *logrus.Entry, id uint, hash string, data []byte) bool {
+
+	txn := s.newrelic.StartTransaction("restoreKillmail")
+	defer txn.End()
+	ctx = newrelic.NewContext(ctx, txn)
+
+	entry = entry.WithFields(logrus.Fields{
+		"id":   id,
+		"hash": hash,
+	})
+
+	killmail, err := esi.ParseKillmail(data, hash)
+	if err != nil {
+		txn.NoticeError(err)
+		entry.WithError(err).Error("failed to parse backed up killmail")
+		return false
+	}
+
+	if killmail.ID != id {
+		txn.Ignore()
+		entry.WithField("killmail_id", killmail.ID).Error("backed up killmail does not match its recorded id, skipping")
+		return false
+	}
+
+	killmail, err = s.importKillmail(ctx, entry, killmail)
+	if err != nil {
+		txn.NoticeError(err)
+		entry.WithError(err).Error("failed to restore killmail")
+		return false
+	}
+
+	if killmail == nil {
+		txn.Ignore()
+		return false
+	}
+
+	// Restored killmails are counted by the stats consumer just like imported ones
+	s.publishStats(ctx, entry, killmail)
+
+	return true
+
+}
@@ -3,6 +3,7 @@ package killmail
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/eveisesi/neo"
 	"github.com/eveisesi/neo/services/alliance"
@@ -11,6 +12,7 @@ import (
 	"github.com/eveisesi/neo/services/corporation"
 	"github.com/eveisesi/neo/services/esi"
 	"github.com/eveisesi/neo/services/market"
+	"github.com/eveisesi/neo/services/queue"
 	"github.com/eveisesi/neo/services/tracker"
 	"github.com/eveisesi/neo/services/universe"
 	"github.com/go-redis/redis/v8"
@@ -25,25 +27,38 @@ type (
 		// Business Appliances
 		HistoryExporter(mindate, maxdate, direction string, overrideCurrent, datehold bool, threshold int64) error
 		Importer(gLimit, gSleep int64) error
+		Restore(ctx context.Context, dir string, from, to time.Time, gLimit int64) (int64, error)
 		Websocket() error
-		// Recalculator(gLimit int64)
-		// RecalculatorDispatcher(limit, trigger int64, after uint64)
-		DispatchPayload(msg *neo.Message)
+		Recalculate(ctx context.Context, filter BackfillFilter, dryRun bool, gLimit int64, report func(*Revaluation)) (int64, error)
+		Reclassify(ctx context.Context, filter BackfillFilter, gLimit int64) (int64, error)
+		RegisterClassifier(tag neo.KillmailTag, classifier Classifier)
+		DispatchPayload(lane neo.Lane, msg *neo.Message)
+
+		// Dead Letter Queue
+		DeadLetters(ctx context.Context, limit int64) ([]*neo.Message, int64, error)
+		DeadLetter(ctx context.Context, id uint) ([]*neo.Message, error)
+		ReplayDeadLetters(ctx context.Context, ids ...uint) (int, error)
+		PurgeDeadLetters(ctx context.Context, ids ...uint) (int64, error)
 
 		// Killmails
 		Killmail(ctx context.Context, id uint) (*neo.Killmail, error)
 		FullKillmail(ctx context.Context, id uint, withNames bool) (*neo.Killmail, error)
 		RecentKillmails(ctx context.Context, page int) ([]*neo.Killmail, error)
-		KillmailsByCharacterID(ctx context.Context, id uint64, after uint, mods ...*neo.Operator) ([]*neo.Killmail, error)
-		KillmailsByCorporationID(ctx context.Context, id uint, page uint, additionalOps ...*neo.Operator) ([]*neo.Killmail, error)
-		KillmailsByAllianceID(ctx context.Context, id uint, page uint, additionalOps ...*neo.Operator) ([]*neo.Killmail, error)
-		KillmailsByShipID(ctx context.Context, id uint, page uint, additionalOps ...*neo.Operator) ([]*neo.Killmail, error)
-		KillmailsByShipGroupID(ctx context.Context, id uint, page uint, additionalOps ...*neo.Operator) ([]*neo.Killmail, error)
-		KillmailsBySystemID(ctx context.Context, id uint, page uint, additionalOps ...*neo.Operator) ([]*neo.Killmail, error)
-		KillmailsByConstellationID(ctx context.Context, id uint, page uint, additionalOps ...*neo.Operator) ([]*neo.Killmail, error)
-		KillmailsByRegionID(ctx context.Context, id uint, page uint, additionalOps ...*neo.Operator) ([]*neo.Killmail, error)
+		KillmailsByCharacterID(ctx context.Context, id uint64, args neo.ConnectionArgs, additionalOps ...*neo.Operator) (*neo.KillmailConnection, error)
+		KillmailsByCorporationID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOps ...*neo.Operator) (*neo.KillmailConnection, error)
+		KillmailsByAllianceID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOps ...*neo.Operator) (*neo.KillmailConnection, error)
+		KillmailsByShipID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOps ...*neo.Operator) (*neo.KillmailConnection, error)
+		KillmailsByShipGroupID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOps ...*neo.Operator) (*neo.KillmailConnection, error)
+		KillmailsBySystemID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOps ...*neo.Operator) (*neo.KillmailConnection, error)
+		KillmailsByConstellationID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOps ...*neo.Operator) (*neo.KillmailConnection, error)
+		KillmailsByRegionID(ctx context.Context, id uint, args neo.ConnectionArgs, additionalOps ...*neo.Operator) (*neo.KillmailConnection, error)
+
+		KillmailsByWatchlist(ctx context.Context, accountID uint64, entries []*neo.WatchlistEntry, args neo.ConnectionArgs) (*neo.KillmailConnection, error)
+		RelatedKillmails(ctx context.Context, killmail *neo.Killmail, window time.Duration, radius neo.RelatedRadius) ([]*neo.RelatedKillmails, error)
 
 		MostValuable(ctx context.Context, column string, id uint64, age, limit int) ([]*neo.Killmail, error)
+		Activity(ctx context.Context, entity neo.StatEntity, id uint64, days int) (*neo.Activity, error)
+		TopShips(ctx context.Context, entity neo.StatEntity, id uint64, usage neo.ShipUsage, grouping neo.ShipGrouping, since time.Time, limit int, operators ...*neo.Operator) ([]*neo.ShipCount, error)
 	}
 
 	WSPayload struct {
@@ -71,7 +86,13 @@ type (
 		universe    universe.Service
 		market      market.Service
 		tracker     tracker.Service
+		queue       queue.Service
 		killmails   neo.KillmailRepository
+		rules       neo.NotificationRuleRepository
+		deliveries  neo.NotificationDeliveryRepository
+
+		classifiers       []*classifierRule
+		notificationRules *ruleCache
 	}
 )
 
@@ -96,11 +117,14 @@ func NewService(
 	universe universe.Service,
 	market market.Service,
 	tracker tracker.Service,
+	queue queue.Service,
 
 	// Repositories
 	killmails neo.KillmailRepository,
+	rules neo.NotificationRuleRepository,
+	deliveries neo.NotificationDeliveryRepository,
 ) Service {
-	return &service{
+	s := &service{
 		client,
 		redis,
 		nr,
@@ -114,8 +138,17 @@ func NewService(
 		universe,
 		market,
 		tracker,
+		queue,
 		killmails,
+		rules,
+		deliveries,
+		make([]*classifierRule, 0),
+		new(ruleCache),
 	}
+
+	s.registerDefaultClassifiers()
+
+	return s
 }
 
 func ChunkSliceKillmails(slice []*neo.Killmail, size int) [][]*neo.Killmail {
@@ -0,0 +1,124 @@
+package killmail
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+	"github.com/sirkon/go-format"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxTopShips caps the number of ships returned by TopShips
+	maxTopShips = 50
+	// topShipsCacheDuration is how long a ship breakdown is cached
+	topShipsCacheDuration = time.Minute * 10
+)
+
+// TopShips counts the ships of the entity's killmails that occurred since the given time, largest first. Flown ships
+// are the ships the entity's own attackers flew on its kills, killed and lost ships are the victim ships of its kills
+// and losses. Operators further narrow down the killmails that are counted
+func (s *service) TopShips(ctx context.Context, entity neo.StatEntity, id uint64, usage neo.ShipUsage, grouping neo.ShipGrouping, since time.Time, limit int, operators ...*neo.Operator) ([]*neo.ShipCount, error) {
+
+	column, ok := entity.InvolvementColumn()
+	if !ok {
+		return nil, errors.Errorf("ship breakdowns are not available for %s", entity)
+	}
+
+	if limit < 1 || limit > maxTopShips {
+		return nil, errors.Errorf("limit must be between 1 and %d", maxTopShips)
+	}
+
+	var (
+		involvement = neo.NewEqualOperator(fmt.Sprintf("attackers.%s", column), id)
+		attackers   []*neo.Operator
+		shipColumn  = fmt.Sprintf("victim.%s", grouping.Column())
+	)
+
+	switch usage {
+	case neo.ShipUsageFlown:
+		attackers = []*neo.Operator{involvement}
+		shipColumn = fmt.Sprintf("attackers.%s", grouping.Column())
+	case neo.ShipUsageKilled:
+	case neo.ShipUsageLost:
+		involvement = neo.NewEqualOperator(fmt.Sprintf("victim.%s", column), id)
+	default:
+		return nil, errors.Errorf("%s is not a valid ship usage", usage)
+	}
+
+	// since is truncated to the hour so that requests made within the same hour share a cache entry
+	operators = append(
+		operators,
+		involvement,
+		neo.NewGreaterThanEqualToOperator("killmailTime", since.UTC().Truncate(time.Hour)),
+	)
+
+	modsMarshaled, err := json.Marshal(append(operators, neo.NewLimitOperator(int64(limit))))
+	if err != nil {
+		return nil, err
+	}
+
+	var key = format.Formatm(neo.REDIS_TOP_SHIPS, format.Values{
+		"usage":    usage,
+		"grouping": grouping,
+		"entity":   entity,
+		"id":       id,
+		"mods":     fmt.Sprintf("%x", sha256.Sum256(modsMarshaled)),
+	})
+
+	entry := s.logger.WithFields(logrus.Fields{
+		"key":   key,
+		"class": "TopShips",
+	})
+
+	cached, err := s.redis.Get(ctx, key).Bytes()
+	if err != nil && err.Error() != neo.ErrRedisNil.Error() {
+		return nil, err
+	}
+
+	if len(cached) > 0 {
+		var counts = make([]*neo.ShipCount, 0)
+		err = json.Unmarshal(cached, &counts)
+		if err == nil {
+			entry.Info("cache hit. returning ship counts")
+			return counts, nil
+		}
+		entry.WithError(err).Error("unable to unmarshal ship counts from cache")
+	}
+
+	entry.Info("cache miss, aggregating ship counts from db")
+
+	results, err := s.killmails.KillmailCounts(ctx, shipColumn, attackers, false, int64(limit), operators...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to aggregate ship counts")
+	}
+
+	counts := make([]*neo.ShipCount, 0, len(results))
+	for _, result := range results {
+		counts = append(counts, &neo.ShipCount{
+			ID:         uint(result.ID),
+			Grouping:   grouping,
+			Killmails:  result.Killmails,
+			TotalValue: result.TotalValue,
+		})
+	}
+
+	data, err := json.Marshal(counts)
+	if err != nil {
+		entry.WithError(err).Error("unable to marshal ship counts for cache")
+		return counts, nil
+	}
+
+	err = s.redis.Set(ctx, key, data, topShipsCacheDuration).Err()
+	if err != nil {
+		entry.WithError(err).Error("failed to cache ship counts in redis")
+	}
+
+	return counts, nil
+
+}
@@ -0,0 +1,31 @@
+package killmail
+
+import (
+	"context"
+
+	"github.com/eveisesi/neo"
+)
+
+// KillmailsByWatchlist returns a page of the killmails that any of the watched entities were involved in, newest first.
+// Watchlist killmails page with the same (killmailTime, id) cursors as the killmails of a single entity
+func (s *service) KillmailsByWatchlist(ctx context.Context, accountID uint64, entries []*neo.WatchlistEntry, args neo.ConnectionArgs) (*neo.KillmailConnection, error) {
+
+	watched := make([]*neo.Operator, 0, len(entries))
+	for _, entry := range entries {
+		operator := watchOperator(entry.Entity, entry.EntityID)
+		if operator == nil {
+			continue
+		}
+		watched = append(watched, operator)
+	}
+
+	if len(watched) == 0 {
+		return &neo.KillmailConnection{
+			Edges:    make([]*neo.KillmailEdge, 0),
+			PageInfo: &neo.PageInfo{},
+		}, nil
+	}
+
+	return s.killmailConnection(ctx, "KillmailsByWatchlist", "watchlist", accountID, args, neo.NewOrOperator(watched...))
+
+}
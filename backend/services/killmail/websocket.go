@@ -9,7 +9,6 @@ import (
 	"github.com/eveisesi/neo"
 	"github.com/newrelic/go-agent/v3/newrelic"
 
-	"github.com/go-redis/redis/v8"
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -73,7 +72,7 @@ func (s *service) Websocket() error {
 				Hash: message["hash"].(string),
 			}
 
-			go s.DispatchPayload(neoMsg)
+			go s.DispatchPayload(neo.LaneLive, neoMsg)
 		}
 
 		s.logger.Info("bottom of parent loop. Sleep and attemp to reconnect")
@@ -82,12 +81,14 @@ func (s *service) Websocket() error {
 
 }
 
-func (s *service) DispatchPayload(msg *neo.Message) {
+// DispatchPayload pushes a killmail id and hash onto the processing queue for the provided lane
+func (s *service) DispatchPayload(lane neo.Lane, msg *neo.Message) {
 	txn := s.newrelic.StartTransaction("listen")
 	defer txn.End()
 
 	txn.AddAttribute("id", msg.ID)
 	txn.AddAttribute("hash", msg.Hash)
+	txn.AddAttribute("lane", lane.String())
 
 	ctx := newrelic.NewContext(context.Background(), txn)
 
@@ -97,7 +98,7 @@ func (s *service) DispatchPayload(msg *neo.Message) {
 		s.logger.WithContext(ctx).WithError(err).Error("unable to marshal WSSPayload")
 		return
 	}
-	_, err = s.redis.ZAdd(ctx, neo.QUEUES_KILLMAIL_PROCESSING, &redis.Z{Score: float64(msg.ID), Member: string(payload)}).Result()
+	err = s.queue.Publish(ctx, lane.Queue(), payload)
 	if err != nil {
 		txn.NoticeError(err)
 		s.logger.WithContext(ctx).WithError(err).WithField("payload", string(payload)).Error("unable to push killmail to processing queue")
@@ -107,6 +108,7 @@ func (s *service) DispatchPayload(msg *neo.Message) {
 	s.logger.WithContext(ctx).WithFields(logrus.Fields{
 		"id":   msg.ID,
 		"hash": msg.Hash,
+		"lane": lane,
 	}).Info("payload dispatched successfully")
 }
 
@@ -0,0 +1,352 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/go-redis/redis/v8"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/volatiletech/null"
+)
+
+const (
+	// maxRetryDelay caps the exponential backoff between attempts
+	maxRetryDelay = time.Hour
+	// maxResponseLength is how much of a failed response is kept on the delivery
+	maxResponseLength = 512
+	// claimTimeout is how long a claim on a delivery is honoured. It comfortably outlasts a single attempt, so a
+	// claim that is older belongs to a consumer that died mid attempt
+	claimTimeout = time.Minute * 5
+	// staleDeliveryAge is how long an unsettled delivery may go untouched before the sweep assumes its queue
+	// message was lost
+	staleDeliveryAge = time.Minute * 10
+	// sweepInterval is how often, across every consumer, the sweep for stale deliveries runs
+	sweepInterval = time.Minute
+	// sweepBatchSize caps the number of stale deliveries requeued by a single sweep
+	sweepBatchSize = 100
+)
+
+// processMessage claims the delivery, attempts it and records the outcome. Deliveries that have already settled, or
+// that another consumer has claimed, are skipped, so a delivery that is queued twice or reclaimed from the queue
+// while it is being sent is only sent once. An error is only returned when the delivery could not be claimed or
+// its outcome could not be recorded and the message should be redelivered
+func (s *service) processMessage(id string) error {
+
+	txn := s.newrelic.StartTransaction("process notification message")
+	defer txn.End()
+
+	ctx := newrelic.NewContext(context.Background(), txn)
+
+	delivery, err := s.deliveries.NotificationDelivery(ctx, id)
+	if err != nil {
+		txn.NoticeError(err)
+		return errors.Wrap(err, "failed to fetch notification delivery")
+	}
+
+	entry := s.logger.WithContext(ctx).WithFields(logrus.Fields{
+		"id":          delivery.KillmailID,
+		"hash":        delivery.Hash,
+		"destination": delivery.Destination.Type,
+		"delivery_id": delivery.ID,
+		"attempt":     delivery.Attempts + 1,
+	})
+
+	if delivery.Status == neo.NotificationDeliveryDelivered || delivery.Status == neo.NotificationDeliveryFailed {
+		entry.WithField("status", delivery.Status).Info("notification delivery already settled, skipping")
+		return nil
+	}
+
+	claimed, err := s.deliveries.ClaimNotificationDelivery(ctx, delivery.ID, delivery.Attempts, time.Now().Add(-claimTimeout))
+	if err != nil {
+		txn.NoticeError(err)
+		return errors.Wrap(err, "failed to claim notification delivery")
+	}
+
+	if !claimed {
+		entry.Info("notification delivery claimed by another consumer, skipping")
+		return nil
+	}
+
+	retryAfter, err := s.attempt(ctx, txn, delivery)
+	delivery.Attempts++
+	delivery.LastAttemptAt = null.TimeFrom(time.Now())
+	delivery.NextAttemptAt = null.Time{}
+
+	switch {
+	case err == nil:
+		delivery.Status = neo.NotificationDeliveryDelivered
+		delivery.LastError = ""
+		entry.Info("notification processed successfully")
+	case isPermanent(err) || delivery.Attempts >= s.config.NotificationMaxAttempts:
+		txn.NoticeError(err)
+		delivery.Status = neo.NotificationDeliveryFailed
+		delivery.LastError = err.Error()
+		entry.WithError(err).Error("notification delivery failed")
+	default:
+		delay := retryDelay(s.config.NotificationRetryDelay, delivery.Attempts)
+		if retryAfter > delay {
+			delay = retryAfter
+		}
+
+		delivery.Status = neo.NotificationDeliveryRetrying
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = null.TimeFrom(time.Now().Add(delay))
+		entry.WithError(err).WithField("retry_in", delay).Warn("notification delivery failed, scheduling retry")
+	}
+
+	_, err = s.deliveries.UpdateNotificationDelivery(ctx, delivery.ID, delivery)
+	if err != nil {
+		txn.NoticeError(err)
+		return errors.Wrap(err, "failed to record notification delivery")
+	}
+
+	if delivery.Status != neo.NotificationDeliveryRetrying {
+		return nil
+	}
+
+	err = s.redis.ZAdd(ctx, neo.QUEUES_NOTIFICATION_RETRY, &redis.Z{
+		Score:  float64(delivery.NextAttemptAt.Time.Unix()),
+		Member: delivery.ID,
+	}).Err()
+
+	return errors.Wrap(err, "failed to schedule notification retry")
+
+}
+
+// permanentError marks failures that retrying will not fix
+type permanentError struct{ error }
+
+func isPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
+
+// attempt sends the killmail to the destination of the delivery and records the response code on it. When the
+// destination asks us to slow down, the delay it asked for is returned alongside the error
+func (s *service) attempt(ctx context.Context, txn *newrelic.Transaction, delivery *neo.NotificationDelivery) (time.Duration, error) {
+
+	notifier, ok := s.notifiers[delivery.Destination.Type]
+	if !ok {
+		return 0, permanentError{errors.Errorf("no notifier registered for destination type %s", delivery.Destination.Type)}
+	}
+
+	killmail, err := s.killmail.FullKillmail(ctx, delivery.KillmailID, true)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to retrieve killmail")
+	}
+
+	req, err := notifier.Request(ctx, delivery.Destination, killmail)
+	if err != nil {
+		return 0, permanentError{errors.Wrap(err, "failed to build webhook request")}
+	}
+
+	extSeg := newrelic.StartExternalSegment(txn, req)
+	response, err := s.client.Do(req)
+	if err != nil {
+		extSeg.End()
+		return 0, errors.Wrap(err, "failed to make request to webhook")
+	}
+	extSeg.Response = response
+	extSeg.End()
+	defer response.Body.Close()
+
+	delivery.ResponseCode = response.StatusCode
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return 0, nil
+	}
+
+	data, _ := ioutil.ReadAll(io.LimitReader(response.Body, maxResponseLength))
+	err = fmt.Errorf("webhook responded with %d: %s", response.StatusCode, data)
+
+	if response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500 {
+		return parseRetryAfter(response.Header.Get("Retry-After")), err
+	}
+
+	return 0, permanentError{err}
+
+}
+
+// retryDelay doubles the base delay for every attempt that has been made
+func retryDelay(base time.Duration, attempts uint) time.Duration {
+
+	delay := base
+	for i := uint(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	return delay
+
+}
+
+// parseRetryAfter understands both forms of the Retry-After header, a number of seconds or an HTTP date
+func parseRetryAfter(value string) time.Duration {
+
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	// Dates that have already passed do not delay the retry
+	if at, err := http.ParseTime(value); err == nil && time.Until(at) > 0 {
+		return time.Until(at)
+	}
+
+	return 0
+
+}
+
+// requeueDueRetries moves deliveries whose next attempt is due from the retry set back onto the notification queue
+func (s *service) requeueDueRetries(ctx context.Context) (int, error) {
+
+	due, err := s.redis.ZRangeByScoreWithScores(ctx, neo.QUEUES_NOTIFICATION_RETRY, &redis.ZRangeBy{
+		Min: "-inf",
+		Max: strconv.FormatInt(time.Now().Unix(), 10),
+	}).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch due notification retries")
+	}
+
+	requeued := 0
+	for _, retry := range due {
+		id, ok := retry.Member.(string)
+		if !ok {
+			continue
+		}
+
+		// Only the replica that removes the id from the set requeues it
+		removed, err := s.redis.ZRem(ctx, neo.QUEUES_NOTIFICATION_RETRY, id).Result()
+		if err != nil {
+			return requeued, errors.Wrap(err, "failed to remove notification retry")
+		}
+
+		if removed == 0 {
+			continue
+		}
+
+		err = s.publishDelivery(ctx, id)
+		if err != nil {
+			// Put the retry back so that the next pass picks it up again
+			zerr := s.redis.ZAdd(ctx, neo.QUEUES_NOTIFICATION_RETRY, &retry).Err()
+			if zerr != nil {
+				s.logger.WithContext(ctx).WithError(zerr).WithField("delivery_id", id).Error("failed to restore notification retry")
+			}
+			return requeued, err
+		}
+
+		requeued++
+	}
+
+	return requeued, nil
+
+}
+
+// requeueStaleDeliveries recovers deliveries whose queue message was lost: pending deliveries whose publish failed,
+// sending deliveries whose consumer died mid attempt and retrying deliveries missing from the retry set. Requeueing
+// a delivery that is merely slow is harmless, since a delivery is only ever sent once. The sweep runs at most once
+// per sweepInterval across every consumer
+func (s *service) requeueStaleDeliveries(ctx context.Context) (int, error) {
+
+	acquired, err := s.redis.SetNX(ctx, neo.REDIS_NOTIFICATION_SWEEP, time.Now().Unix(), sweepInterval).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to acquire notification sweep lock")
+	}
+
+	if !acquired {
+		return 0, nil
+	}
+
+	deliveries, err := s.deliveries.NotificationDeliveries(
+		ctx,
+		neo.NewInOperator("status", []neo.OpValue{
+			neo.NotificationDeliveryPending,
+			neo.NotificationDeliverySending,
+			neo.NotificationDeliveryRetrying,
+		}),
+		neo.NewLessThanOperator("updatedAt", time.Now().Add(-staleDeliveryAge)),
+		neo.NewOrderOperator("updatedAt", neo.SortAsc),
+		neo.NewLimitOperator(sweepBatchSize),
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch stale notification deliveries")
+	}
+
+	requeued := 0
+	for _, delivery := range deliveries {
+		switch delivery.Status {
+		case neo.NotificationDeliveryRetrying:
+			// Retries waiting on their backoff are still in the retry set. Ones that are not were lost between
+			// leaving the set and being published, so they are due now
+			err = s.redis.ZAddNX(ctx, neo.QUEUES_NOTIFICATION_RETRY, &redis.Z{
+				Score:  float64(time.Now().Unix()),
+				Member: delivery.ID,
+			}).Err()
+			if err != nil {
+				return requeued, errors.Wrap(err, "failed to restore notification retry")
+			}
+			continue
+		case neo.NotificationDeliveryPending:
+			// Touching the delivery keeps a pending delivery that is stuck behind a backlog from being queued
+			// again by every sweep
+			err = s.deliveries.TouchNotificationDelivery(ctx, delivery.ID, delivery.Status)
+			if err != nil {
+				return requeued, errors.Wrap(err, "failed to touch stale notification delivery")
+			}
+		}
+
+		err = s.publishDelivery(ctx, delivery.ID)
+		if err != nil {
+			return requeued, err
+		}
+
+		requeued++
+	}
+
+	return requeued, nil
+
+}
+
+// publishDelivery queues the delivery by its id. The consumer loads the delivery itself, which keeps the
+// destination secret out of the queue
+func (s *service) publishDelivery(ctx context.Context, id string) error {
+
+	payload, err := json.Marshal(neo.NotificationDelivery{ID: id})
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(s.queue.Publish(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION, payload), "failed to publish notification delivery")
+
+}
+
+// Deliveries returns the most recent deliveries, optionally narrowed down to a single status
+func (s *service) Deliveries(ctx context.Context, limit int64, status neo.NotificationDeliveryStatus) ([]*neo.NotificationDelivery, error) {
+
+	operators := []*neo.Operator{
+		neo.NewOrderOperator("createdAt", neo.SortDesc),
+		neo.NewLimitOperator(limit),
+	}
+
+	if status != "" {
+		operators = append(operators, neo.NewEqualOperator("status", status))
+	}
+
+	return s.deliveries.NotificationDeliveries(ctx, operators...)
+
+}
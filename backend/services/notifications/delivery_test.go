@@ -0,0 +1,61 @@
+package notifications
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		base     time.Duration
+		attempts uint
+		want     time.Duration
+	}{
+		{"no attempts", time.Second * 30, 0, time.Second * 30},
+		{"first attempt", time.Second * 30, 1, time.Second * 30},
+		{"second attempt", time.Second * 30, 2, time.Minute},
+		{"fifth attempt", time.Second * 30, 5, time.Minute * 8},
+		{"capped", time.Second * 30, 10, maxRetryDelay},
+		{"capped after many attempts", time.Second * 30, 1000, maxRetryDelay},
+		{"base above cap", maxRetryDelay * 2, 1, maxRetryDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryDelay(tt.base, tt.attempts); got != tt.want {
+				t.Errorf("retryDelay() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+}
+
+func TestParseRetryAfter(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{"empty", "", 0, 0},
+		{"seconds", "120", time.Minute * 2, time.Minute * 2},
+		{"zero seconds", "0", 0, 0},
+		{"negative seconds", "-30", 0, 0},
+		{"garbage", "soon", 0, 0},
+		{"future date", time.Now().Add(time.Minute * 2).UTC().Format(http.TimeFormat), time.Minute, time.Minute * 2},
+		{"past date", time.Now().Add(-time.Minute * 2).UTC().Format(http.TimeFormat), 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value); got < tt.min || got > tt.max {
+				t.Errorf("parseRetryAfter(%q) = %s, want between %s and %s", tt.value, got, tt.min, tt.max)
+			}
+		})
+	}
+
+}
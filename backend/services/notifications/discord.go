@@ -0,0 +1,104 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/tools"
+)
+
+// discordEmbedColor is the color of the bar along the side of the embed
+const discordEmbedColor = 0xB22222
+
+type (
+	// discordNotifier posts killmails to Discord webhooks as a rich embed
+	discordNotifier struct {
+		config *neo.Config
+	}
+
+	discordPayload struct {
+		Embeds []*discordEmbed `json:"embeds"`
+	}
+
+	discordEmbed struct {
+		Title     string          `json:"title"`
+		URL       string          `json:"url,omitempty"`
+		Color     int             `json:"color"`
+		Timestamp string          `json:"timestamp"`
+		Author    *discordAuthor  `json:"author,omitempty"`
+		Thumbnail *discordImage   `json:"thumbnail,omitempty"`
+		Fields    []*discordField `json:"fields"`
+	}
+
+	discordAuthor struct {
+		Name    string `json:"name"`
+		URL     string `json:"url,omitempty"`
+		IconURL string `json:"icon_url,omitempty"`
+	}
+
+	discordImage struct {
+		URL string `json:"url"`
+	}
+
+	discordField struct {
+		Name   string `json:"name"`
+		Value  string `json:"value"`
+		Inline bool   `json:"inline"`
+	}
+)
+
+func (n *discordNotifier) Request(ctx context.Context, destination neo.NotificationDestination, killmail *neo.Killmail) (*http.Request, error) {
+
+	b, err := json.Marshal(discordPayload{Embeds: []*discordEmbed{n.embed(killmail)}})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, destination.URL, bytes.NewBuffer(b))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+
+}
+
+func (n *discordNotifier) embed(killmail *neo.Killmail) *discordEmbed {
+
+	embed := &discordEmbed{
+		Title:     fmt.Sprintf("%s destroyed in %s", shipString(killmail.Victim.Ship), systemString(killmail.System)),
+		URL:       fmt.Sprintf("%s/kill/%d", n.config.SlackActionBaseURL, killmail.ID),
+		Color:     discordEmbedColor,
+		Timestamp: killmail.KillmailTime.UTC().Format("2006-01-02T15:04:05Z"),
+		Author: &discordAuthor{
+			Name:    victimString(killmail.Victim),
+			IconURL: victimImageURL(killmail.Victim),
+		},
+		Thumbnail: &discordImage{
+			URL: fmt.Sprintf("%s/types/%d/render?size=%d", neo.EVE_IMAGE_URL, killmail.Victim.ShipTypeID, 128),
+		},
+		Fields: []*discordField{
+			{Name: "Total Value", Value: fmt.Sprintf("%s ISK", tools.AbbreviateNumber(killmail.TotalValue)), Inline: true},
+			{Name: "Dropped", Value: fmt.Sprintf("%s ISK", tools.AbbreviateNumber(killmail.DroppedValue)), Inline: true},
+			{Name: "Destroyed", Value: fmt.Sprintf("%s ISK", tools.AbbreviateNumber(killmail.DestroyedValue)), Inline: true},
+			{Name: "Attackers", Value: strconv.Itoa(len(killmail.Attackers)), Inline: true},
+			{Name: "Damage Taken", Value: strconv.FormatUint(uint64(killmail.Victim.DamageTaken), 10), Inline: true},
+		},
+	}
+
+	if killmail.Victim.CharacterID != nil {
+		embed.Author.URL = fmt.Sprintf("%s/characters/%d", n.config.SlackActionBaseURL, *killmail.Victim.CharacterID)
+	} else if killmail.Victim.CorporationID != nil {
+		embed.Author.URL = fmt.Sprintf("%s/corporations/%d", n.config.SlackActionBaseURL, *killmail.Victim.CorporationID)
+	}
+
+	return embed
+
+}
@@ -0,0 +1,80 @@
+package notifications
+
+import (
+	"fmt"
+
+	"github.com/eveisesi/neo"
+)
+
+// shipString names the ship and its group
+func shipString(ship *neo.Type) string {
+
+	if ship == nil {
+		return ""
+	}
+
+	if ship.Group != nil {
+		return fmt.Sprintf("%s (%s)", ship.Name, ship.Group.Name)
+	}
+
+	return ship.Name
+
+}
+
+// systemString names the system with its security status and region
+func systemString(system *neo.SolarSystem) string {
+
+	if system == nil {
+		return ""
+	}
+
+	response := fmt.Sprintf("%s ( %.3f )", system.Name, system.Security)
+
+	if system.Constellation != nil && system.Constellation.Region != nil {
+		response = fmt.Sprintf("%s / %s", response, system.Constellation.Region.Name)
+	}
+
+	return response
+
+}
+
+// victimString names the victim with the ticker of their corporation
+func victimString(victim *neo.KillmailVictim) string {
+	response := ""
+
+	if victim.Character != nil {
+		response = victim.Character.Name
+	}
+	if victim.Character == nil && victim.Corporation != nil {
+		response = victim.Corporation.Name
+	}
+
+	if victim.Corporation != nil {
+		response = fmt.Sprintf("[%s] %s", victim.Corporation.Ticker, response)
+	}
+
+	if victim.Character == nil && victim.Alliance != nil {
+		response = fmt.Sprintf("%s (%s)", response, victim.Alliance.Name)
+	}
+
+	if response == "" {
+		response = "Unknown Victim"
+	}
+
+	return response
+}
+
+// victimImageURL links to the portrait of the victim, or the logo of their corporation when the victim is not a character
+func victimImageURL(victim *neo.KillmailVictim) string {
+	format := "%s/%s/%d/%s?size=%d"
+
+	if victim.CharacterID != nil {
+		link := fmt.Sprintf(format, neo.EVE_IMAGE_URL, "characters", *victim.CharacterID, "portrait", 128)
+		return link
+	}
+
+	if victim.CorporationID != nil {
+		return fmt.Sprintf(format, neo.EVE_IMAGE_URL, "corporations", *victim.CorporationID, "logo", 128)
+	}
+	return ""
+}
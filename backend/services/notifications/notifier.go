@@ -0,0 +1,26 @@
+package notifications
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/eveisesi/neo"
+)
+
+// Notifier formats killmails for one type of destination. The request it builds is sent by the queue runner,
+// so notifiers only need to know how to shape the payload for their destination
+type Notifier interface {
+	Request(ctx context.Context, destination neo.NotificationDestination, killmail *neo.Killmail) (*http.Request, error)
+}
+
+// RegisterNotifier makes the notifier responsible for every delivery to the destination type, replacing any
+// notifier previously registered for it
+func (s *service) RegisterNotifier(destination neo.NotificationDestinationType, notifier Notifier) {
+	s.notifiers[destination] = notifier
+}
+
+func (s *service) registerDefaultNotifiers() {
+	s.RegisterNotifier(neo.NotificationDestinationSlack, &slackNotifier{config: s.config})
+	s.RegisterNotifier(neo.NotificationDestinationDiscord, &discordNotifier{config: s.config})
+	s.RegisterNotifier(neo.NotificationDestinationWebhook, &webhookNotifier{})
+}
@@ -0,0 +1,62 @@
+package notifications
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+)
+
+func (s *service) Rules(ctx context.Context) ([]*neo.NotificationRule, error) {
+	return s.rules.NotificationRules(ctx, neo.NewOrderOperator("createdAt", neo.SortAsc))
+}
+
+// CreateRule validates and stores a new notification rule. The importer picks new rules up within a minute
+func (s *service) CreateRule(ctx context.Context, rule *neo.NotificationRule) (*neo.NotificationRule, error) {
+
+	if rule.Name == "" {
+		return nil, errors.New("rule name is required")
+	}
+
+	if !rule.Destination.Type.IsValid() {
+		return nil, errors.Errorf("%s is not a valid destination type", rule.Destination.Type)
+	}
+
+	uri, err := url.Parse(rule.Destination.URL)
+	if err != nil || (uri.Scheme != "https" && uri.Scheme != "http") || uri.Host == "" {
+		return nil, errors.New("destination url must be an absolute http(s) url")
+	}
+
+	if rule.Destination.Type == neo.NotificationDestinationWebhook && rule.Destination.Secret == "" {
+		return nil, errors.New("webhook destinations require a secret to sign payloads with")
+	}
+
+	if rule.Criteria.Side != "" && !rule.Criteria.Side.IsValid() {
+		return nil, errors.Errorf("%s is not a valid side", rule.Criteria.Side)
+	}
+
+	if rule.Criteria.MaxTotalValue > 0 && rule.Criteria.MaxTotalValue < rule.Criteria.MinTotalValue {
+		return nil, errors.New("max total value must not be less than min total value")
+	}
+
+	return s.rules.CreateNotificationRule(ctx, rule)
+
+}
+
+func (s *service) SetRuleEnabled(ctx context.Context, id string, enabled bool) (*neo.NotificationRule, error) {
+
+	rule, err := s.rules.NotificationRule(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch notification rule %s", id)
+	}
+
+	rule.Enabled = enabled
+
+	return s.rules.UpdateNotificationRule(ctx, id, rule)
+
+}
+
+func (s *service) DeleteRule(ctx context.Context, id string) (bool, error) {
+	return s.rules.DeleteNotificationRule(ctx, id)
+}
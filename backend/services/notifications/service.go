@@ -1,16 +1,11 @@
 package notifications
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"io/ioutil"
 	"net/http"
-	"strconv"
 	"time"
 
-	"github.com/eveisesi/neo/tools"
 	"github.com/newrelic/go-agent/v3/newrelic"
 
 	"github.com/eveisesi/neo"
@@ -18,6 +13,8 @@ import (
 	"github.com/eveisesi/neo/services/character"
 	"github.com/eveisesi/neo/services/corporation"
 	"github.com/eveisesi/neo/services/killmail"
+	"github.com/eveisesi/neo/services/queue"
+	"github.com/eveisesi/neo/services/search"
 	"github.com/eveisesi/neo/services/universe"
 	"github.com/go-redis/redis/v8"
 	"github.com/sirupsen/logrus"
@@ -26,12 +23,26 @@ import (
 
 type Service interface {
 	Run(ctx context.Context)
+	RegisterNotifier(destination neo.NotificationDestinationType, notifier Notifier)
+
+	// Rules
+	Rules(ctx context.Context) ([]*neo.NotificationRule, error)
+	CreateRule(ctx context.Context, rule *neo.NotificationRule) (*neo.NotificationRule, error)
+	SetRuleEnabled(ctx context.Context, id string, enabled bool) (*neo.NotificationRule, error)
+	DeleteRule(ctx context.Context, id string) (bool, error)
+
+	// Deliveries
+	Deliveries(ctx context.Context, limit int64, status neo.NotificationDeliveryStatus) ([]*neo.NotificationDelivery, error)
+
+	// Slack
+	SlashCommand(ctx context.Context, text string) *goslack.Msg
 }
 
 type (
 	service struct {
 		client   *http.Client
 		redis    *redis.Client
+		queue    queue.Service
 		logger   *logrus.Logger
 		newrelic *newrelic.Application
 		config   *neo.Config
@@ -42,6 +53,13 @@ type (
 		alliance    alliance.Service
 		universe    universe.Service
 		killmail    killmail.Service
+		search      search.Service
+
+		// Repositories
+		rules      neo.NotificationRuleRepository
+		deliveries neo.NotificationDeliveryRepository
+
+		notifiers map[neo.NotificationDestinationType]Notifier
 	}
 
 	Message struct {
@@ -53,6 +71,7 @@ type (
 func NewService(
 	client *http.Client,
 	redis *redis.Client,
+	queue queue.Service,
 	logger *logrus.Logger,
 	newrelic *newrelic.Application,
 	config *neo.Config,
@@ -63,10 +82,16 @@ func NewService(
 	alliance alliance.Service,
 	universe universe.Service,
 	killmail killmail.Service,
+	search search.Service,
+
+	// Repositories
+	rules neo.NotificationRuleRepository,
+	deliveries neo.NotificationDeliveryRepository,
 ) Service {
-	return &service{
+	s := &service{
 		client,
 		redis,
+		queue,
 		logger,
 		newrelic,
 		config,
@@ -75,7 +100,15 @@ func NewService(
 		alliance,
 		universe,
 		killmail,
+		search,
+		rules,
+		deliveries,
+		make(map[neo.NotificationDestinationType]Notifier),
 	}
+
+	s.registerDefaultNotifiers()
+
+	return s
 }
 
 func (s *service) Run(ctx context.Context) {
@@ -83,267 +116,59 @@ func (s *service) Run(ctx context.Context) {
 	for {
 		txn := s.newrelic.StartTransaction("process notification queue")
 		entry := s.logger.WithContext(ctx)
-		count, err := s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION, "-inf", "+inf").Result()
+
+		requeued, err := s.requeueDueRetries(ctx)
+		if err != nil {
+			txn.NoticeError(err)
+			entry.WithError(err).Error("failed to requeue notification retries")
+		}
+		if requeued > 0 {
+			entry.WithField("requeued", requeued).Info("requeued notification retries")
+		}
+
+		recovered, err := s.requeueStaleDeliveries(ctx)
 		if err != nil {
 			txn.NoticeError(err)
+			entry.WithError(err).Error("failed to requeue stale notification deliveries")
+		}
+		if recovered > 0 {
+			entry.WithField("requeued", recovered).Warn("requeued stale notification deliveries")
+		}
 
-			entry.WithError(err).Error("unable to determine count of message queue")
+		messages, err := s.queue.Consume(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION, 5)
+		if err != nil {
+			txn.NoticeError(err)
+
+			entry.WithError(err).Error("unable to consume messages from notification queue")
 			time.Sleep(time.Second * 2)
 			continue
 		}
 
-		if count == 0 {
+		if len(messages) == 0 {
 
 			entry.Info("notification queue is empty")
 			time.Sleep(time.Second * 15)
 			continue
 		}
 
-		results, err := s.redis.ZPopMax(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION, 5).Result()
-		if err != nil {
-			entry.WithError(err).Fatal("unable to retrieve hashes from queue")
-		}
-
-		for _, result := range results {
-			var message neo.Message
-			err := json.Unmarshal([]byte(result.Member.(string)), &message)
+		for _, result := range messages {
+			var delivery neo.NotificationDelivery
+			err := json.Unmarshal(result.Payload, &delivery)
 			if err != nil {
-				s.logger.WithError(err).WithField("member", result.Member).Error("failed to unmarshal queue payload")
+				s.logger.WithError(err).WithField("member", string(result.Payload)).Error("failed to unmarshal queue payload")
+			} else if err = s.processMessage(delivery.ID); err != nil {
+				// The message is left pending so that it is redelivered once the visibility timeout expires
+				s.logger.WithError(err).WithField("delivery_id", delivery.ID).Error("failed to process notification delivery")
+				continue
 			}
 
-			s.processMessage(message)
+			err = s.queue.Ack(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION, result.ID)
+			if err != nil {
+				s.logger.WithError(err).WithField("message_id", result.ID).Error("failed to ack notification message")
+			}
 			time.Sleep(time.Second * 2)
 		}
 		txn.End()
 	}
 
 }
-
-func (s *service) processMessage(msg neo.Message) {
-
-	txn := s.newrelic.StartTransaction("process notification message")
-	defer txn.End()
-
-	ctx := newrelic.NewContext(context.Background(), txn)
-
-	entry := s.logger.WithContext(ctx).WithFields(logrus.Fields{
-		"id":   msg.ID,
-		"hash": msg.Hash,
-	})
-
-	// Build Killmail
-	killmail, err := s.killmail.FullKillmail(ctx, msg.ID, true)
-	if err != nil {
-		txn.NoticeError(err)
-		entry.WithError(err).Error("Failed to retrieve killmail from DB")
-		return
-	}
-
-	killmailSectionBlock := goslack.NewSectionBlock(
-		goslack.NewTextBlockObject(
-			goslack.MarkdownType,
-			"*Killmail Details*",
-			false, false,
-		),
-		nil,
-		nil,
-	)
-
-	killmailDetailSectionBlock := goslack.NewSectionBlock(
-		nil,
-		[]*goslack.TextBlockObject{
-			goslack.NewTextBlockObject(goslack.MarkdownType, "*Ship*", false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, s.buildSlackShipString(killmail.Victim.Ship), false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, "*System*", false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, s.buildSlackSystemString(killmail.System), false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, "*Killtime*", false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, killmail.KillmailTime.Format("2006-01-02 15:04:05"), false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, "*Damage Taken*", false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, strconv.FormatUint(uint64(killmail.Victim.DamageTaken), 10), false, false),
-		},
-		goslack.NewAccessory(
-			goslack.NewImageBlockElement(
-				fmt.Sprintf("%s/types/%d/render?size=%d", neo.EVE_IMAGE_URL, killmail.Victim.ShipTypeID, 128),
-				s.buildSlackShipString(killmail.Victim.Ship),
-			),
-		),
-	)
-
-	victimSectionBlock := goslack.NewSectionBlock(
-		goslack.NewTextBlockObject(
-			goslack.MarkdownType,
-			"*Victim Details*",
-			false, false,
-		),
-		nil,
-		nil,
-	)
-
-	victimDetailSectionBlock := goslack.NewSectionBlock(
-		nil,
-		[]*goslack.TextBlockObject{
-			goslack.NewTextBlockObject(goslack.MarkdownType, "*Victim*", false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, s.buildSlackVictimString(killmail.Victim), false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, "*ValueDropped*", false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, fmt.Sprintf("%s ISK", tools.AbbreviateNumber(float64(killmail.DroppedValue))), false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, "*ValueDestroyed*", false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, fmt.Sprintf("%s ISK", tools.AbbreviateNumber(float64(killmail.DestroyedValue))), false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, "*Total Value*", false, false),
-			goslack.NewTextBlockObject(goslack.MarkdownType, fmt.Sprintf("%s ISK", tools.AbbreviateNumber(float64(killmail.TotalValue))), false, false),
-		},
-		goslack.NewAccessory(
-			goslack.NewImageBlockElement(
-				s.buildSlackVictimImageString(killmail.Victim),
-				s.buildSlackVictimString(killmail.Victim),
-			),
-		),
-	)
-
-	blockElementSlc := make([]goslack.BlockElement, 0)
-	killmailActionButton := goslack.NewButtonBlockElement("view_killmail", "View Killmail", goslack.NewTextBlockObject(goslack.PlainTextType, "View Killmail", false, false))
-	killmailActionButton.URL = fmt.Sprintf("%s/kill/%d", s.config.SlackActionBaseURL, killmail.ID)
-	blockElementSlc = append(blockElementSlc, killmailActionButton)
-	if killmail.Victim.Character != nil {
-		victimActionButton := goslack.NewButtonBlockElement("view_victim", "View Victim", goslack.NewTextBlockObject(goslack.PlainTextType, "View Victim", false, false))
-		victimActionButton.URL = fmt.Sprintf("%s/characters/%d", s.config.SlackActionBaseURL, killmail.Victim.Character.ID)
-		blockElementSlc = append(blockElementSlc, victimActionButton)
-	} else if killmail.Victim.Corporation != nil {
-		victimActionButton := goslack.NewButtonBlockElement("view_victim", "View Victim", goslack.NewTextBlockObject(goslack.PlainTextType, "View Victim", false, false))
-		victimActionButton.URL = fmt.Sprintf("%s/corporations/%d", s.config.SlackActionBaseURL, killmail.Victim.Corporation.ID)
-		blockElementSlc = append(blockElementSlc, victimActionButton)
-	}
-
-	systemActionButton := goslack.NewButtonBlockElement("view_system", "View System", goslack.NewTextBlockObject(goslack.PlainTextType, "View System", false, false))
-	systemActionButton.URL = fmt.Sprintf("%s/systems/%d", s.config.SlackActionBaseURL, killmail.SolarSystemID)
-	blockElementSlc = append(blockElementSlc, systemActionButton)
-
-	shipActionButton := goslack.NewButtonBlockElement("view_ship", "View Ship", goslack.NewTextBlockObject(goslack.PlainTextType, "View Ship", false, false))
-	shipActionButton.URL = fmt.Sprintf("%s/ships/%d", s.config.SlackActionBaseURL, killmail.Victim.ShipTypeID)
-	blockElementSlc = append(blockElementSlc, shipActionButton)
-
-	actionSectionBlock := goslack.NewActionBlock(
-		"navigate_to_site",
-		blockElementSlc...,
-	)
-
-	attachment := goslack.Attachment{
-		Blocks: goslack.Blocks{
-			BlockSet: []goslack.Block{
-				killmailSectionBlock,
-				goslack.NewDividerBlock(),
-				killmailDetailSectionBlock,
-				goslack.NewDividerBlock(),
-				victimSectionBlock,
-				goslack.NewDividerBlock(),
-				victimDetailSectionBlock,
-				goslack.NewDividerBlock(),
-				actionSectionBlock,
-			},
-		},
-	}
-
-	b, err := json.Marshal(attachment)
-	if err != nil {
-		txn.NoticeError(err)
-		entry.WithError(err).Error("failed to build payload for webhook")
-		return
-	}
-
-	s.logger.WithField("payload", string(b)).Infoln()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.SlackNotifierWebhookURL, bytes.NewBuffer(b))
-	if err != nil {
-		txn.NoticeError(err)
-		entry.WithError(err).Error("failed to build webhook request to slack webhook")
-		return
-	}
-	extSeg := newrelic.StartExternalSegment(txn, req)
-	response, err := s.client.Do(req)
-	if err != nil {
-		txn.NoticeError(err)
-		entry.WithError(err).Error("failed to make request to slack webhook")
-		return
-	}
-	extSeg.Response = response
-	extSeg.End()
-
-	if response.StatusCode > 200 {
-		data, _ := ioutil.ReadAll(response.Body)
-		entry.WithFields(logrus.Fields{
-			"response": string(data),
-			"request":  string(b),
-		}).Error("webhook request to slack failed")
-	}
-
-	entry.Info("notification processed successfully")
-
-}
-
-func (s *service) buildSlackShipString(ship *neo.Type) string {
-
-	if ship == nil {
-		return ""
-	}
-
-	if ship.Group != nil {
-		return fmt.Sprintf("%s (%s)", ship.Name, ship.Group.Name)
-	}
-
-	return ship.Name
-
-}
-
-func (s *service) buildSlackSystemString(system *neo.SolarSystem) string {
-
-	if system == nil {
-		return ""
-	}
-
-	response := fmt.Sprintf("%s ( %.3f )", system.Name, system.Security)
-
-	if system.Constellation != nil && system.Constellation.Region != nil {
-		response = fmt.Sprintf("%s / %s", response, system.Constellation.Region.Name)
-	}
-
-	return response
-
-}
-
-func (s *service) buildSlackVictimString(victim *neo.KillmailVictim) string {
-	response := ""
-
-	if victim.Character != nil {
-		response = victim.Character.Name
-	}
-	if victim.Character == nil && victim.Corporation != nil {
-		response = victim.Corporation.Name
-	}
-
-	if victim.Corporation != nil {
-		response = fmt.Sprintf("[%s] %s", victim.Corporation.Ticker, response)
-	}
-
-	if victim.Character == nil && victim.Alliance != nil {
-		response = fmt.Sprintf("%s (%s)", response, victim.Alliance.Name)
-	}
-
-	if response == "" {
-		response = "Unknown Victim"
-	}
-
-	return response
-}
-
-func (s *service) buildSlackVictimImageString(victim *neo.KillmailVictim) string {
-	format := "%s/%s/%d/%s?size=%d"
-
-	if victim.CharacterID != nil {
-		link := fmt.Sprintf(format, neo.EVE_IMAGE_URL, "characters", *victim.CharacterID, "portrait", 128)
-		return link
-	}
-
-	if victim.CorporationID != nil {
-		return fmt.Sprintf(format, neo.EVE_IMAGE_URL, "corporations", *victim.CorporationID, "logo", 128)
-	}
-	return ""
-}
@@ -0,0 +1,142 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/tools"
+	goslack "github.com/slack-go/slack"
+)
+
+// slackNotifier posts killmails to Slack incoming webhooks formatted with Block Kit
+type slackNotifier struct {
+	config *neo.Config
+}
+
+func (n *slackNotifier) Request(ctx context.Context, destination neo.NotificationDestination, killmail *neo.Killmail) (*http.Request, error) {
+
+	b, err := json.Marshal(goslack.Attachment{
+		Blocks: goslack.Blocks{BlockSet: n.blocks(killmail)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, destination.URL, bytes.NewBuffer(b))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+
+}
+
+func (n *slackNotifier) blocks(killmail *neo.Killmail) []goslack.Block {
+
+	killmailSectionBlock := goslack.NewSectionBlock(
+		goslack.NewTextBlockObject(
+			goslack.MarkdownType,
+			"*Killmail Details*",
+			false, false,
+		),
+		nil,
+		nil,
+	)
+
+	killmailDetailSectionBlock := goslack.NewSectionBlock(
+		nil,
+		[]*goslack.TextBlockObject{
+			goslack.NewTextBlockObject(goslack.MarkdownType, "*Ship*", false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, shipString(killmail.Victim.Ship), false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, "*System*", false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, systemString(killmail.System), false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, "*Killtime*", false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, killmail.KillmailTime.Format("2006-01-02 15:04:05"), false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, "*Damage Taken*", false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, strconv.FormatUint(uint64(killmail.Victim.DamageTaken), 10), false, false),
+		},
+		goslack.NewAccessory(
+			goslack.NewImageBlockElement(
+				fmt.Sprintf("%s/types/%d/render?size=%d", neo.EVE_IMAGE_URL, killmail.Victim.ShipTypeID, 128),
+				shipString(killmail.Victim.Ship),
+			),
+		),
+	)
+
+	victimSectionBlock := goslack.NewSectionBlock(
+		goslack.NewTextBlockObject(
+			goslack.MarkdownType,
+			"*Victim Details*",
+			false, false,
+		),
+		nil,
+		nil,
+	)
+
+	victimDetailSectionBlock := goslack.NewSectionBlock(
+		nil,
+		[]*goslack.TextBlockObject{
+			goslack.NewTextBlockObject(goslack.MarkdownType, "*Victim*", false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, victimString(killmail.Victim), false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, "*ValueDropped*", false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, fmt.Sprintf("%s ISK", tools.AbbreviateNumber(float64(killmail.DroppedValue))), false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, "*ValueDestroyed*", false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, fmt.Sprintf("%s ISK", tools.AbbreviateNumber(float64(killmail.DestroyedValue))), false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, "*Total Value*", false, false),
+			goslack.NewTextBlockObject(goslack.MarkdownType, fmt.Sprintf("%s ISK", tools.AbbreviateNumber(float64(killmail.TotalValue))), false, false),
+		},
+		goslack.NewAccessory(
+			goslack.NewImageBlockElement(
+				victimImageURL(killmail.Victim),
+				victimString(killmail.Victim),
+			),
+		),
+	)
+
+	blockElementSlc := make([]goslack.BlockElement, 0)
+	killmailActionButton := goslack.NewButtonBlockElement("view_killmail", "View Killmail", goslack.NewTextBlockObject(goslack.PlainTextType, "View Killmail", false, false))
+	killmailActionButton.URL = fmt.Sprintf("%s/kill/%d", n.config.SlackActionBaseURL, killmail.ID)
+	blockElementSlc = append(blockElementSlc, killmailActionButton)
+	if killmail.Victim.Character != nil {
+		victimActionButton := goslack.NewButtonBlockElement("view_victim", "View Victim", goslack.NewTextBlockObject(goslack.PlainTextType, "View Victim", false, false))
+		victimActionButton.URL = fmt.Sprintf("%s/characters/%d", n.config.SlackActionBaseURL, killmail.Victim.Character.ID)
+		blockElementSlc = append(blockElementSlc, victimActionButton)
+	} else if killmail.Victim.Corporation != nil {
+		victimActionButton := goslack.NewButtonBlockElement("view_victim", "View Victim", goslack.NewTextBlockObject(goslack.PlainTextType, "View Victim", false, false))
+		victimActionButton.URL = fmt.Sprintf("%s/corporations/%d", n.config.SlackActionBaseURL, killmail.Victim.Corporation.ID)
+		blockElementSlc = append(blockElementSlc, victimActionButton)
+	}
+
+	systemActionButton := goslack.NewButtonBlockElement("view_system", "View System", goslack.NewTextBlockObject(goslack.PlainTextType, "View System", false, false))
+	systemActionButton.URL = fmt.Sprintf("%s/systems/%d", n.config.SlackActionBaseURL, killmail.SolarSystemID)
+	blockElementSlc = append(blockElementSlc, systemActionButton)
+
+	shipActionButton := goslack.NewButtonBlockElement("view_ship", "View Ship", goslack.NewTextBlockObject(goslack.PlainTextType, "View Ship", false, false))
+	shipActionButton.URL = fmt.Sprintf("%s/ships/%d", n.config.SlackActionBaseURL, killmail.Victim.ShipTypeID)
+	blockElementSlc = append(blockElementSlc, shipActionButton)
+
+	actionSectionBlock := goslack.NewActionBlock(
+		"navigate_to_site",
+		blockElementSlc...,
+	)
+
+	return []goslack.Block{
+		killmailSectionBlock,
+		goslack.NewDividerBlock(),
+		killmailDetailSectionBlock,
+		goslack.NewDividerBlock(),
+		victimSectionBlock,
+		goslack.NewDividerBlock(),
+		victimDetailSectionBlock,
+		goslack.NewDividerBlock(),
+		actionSectionBlock,
+	}
+
+}
@@ -0,0 +1,232 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/tools"
+	"github.com/pkg/errors"
+	goslack "github.com/slack-go/slack"
+)
+
+const (
+	slashUsage = "Usage: `/neo kill <id>`, `/neo who <name>` or `/neo top [name]`"
+	// slashResults caps the number of entities or killmails listed in a response
+	slashResults = 5
+	// slashTopAge is how many days back /neo top looks for killmails
+	slashTopAge = 7
+)
+
+// topColumns maps the type of a searchable entity to the killmail column its kills are found by
+var topColumns = map[string]string{
+	"characters":     "attackers.characterID",
+	"corporations":   "attackers.corporationID",
+	"alliances":      "attackers.allianceID",
+	"types":          "attackers.shipTypeID",
+	"systems":        "solarSystemID",
+	"constellations": "constellationID",
+	"regions":        "regionID",
+}
+
+// SlashCommand answers a /neo command issued from Slack. text is everything following the command. Failures are
+// answered with a message that is only visible to the user that issued the command
+func (s *service) SlashCommand(ctx context.Context, text string) *goslack.Msg {
+
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return ephemeral(slashUsage)
+	}
+
+	args := strings.Join(fields[1:], " ")
+
+	var (
+		blocks []goslack.Block
+		err    error
+	)
+
+	switch strings.ToLower(fields[0]) {
+	case "kill":
+		blocks, err = s.slashKill(ctx, args)
+	case "who":
+		blocks, err = s.slashWho(ctx, args)
+	case "top":
+		blocks, err = s.slashTop(ctx, args)
+	default:
+		return ephemeral(slashUsage)
+	}
+	if err != nil {
+		s.logger.WithContext(ctx).WithError(err).WithField("text", text).Error("failed to answer slash command")
+		return ephemeral(err.Error())
+	}
+
+	return &goslack.Msg{
+		ResponseType: goslack.ResponseTypeInChannel,
+		Blocks:       goslack.Blocks{BlockSet: blocks},
+	}
+
+}
+
+func (s *service) slashKill(ctx context.Context, args string) ([]goslack.Block, error) {
+
+	id, err := strconv.ParseUint(args, 10, 32)
+	if err != nil {
+		return nil, errors.Errorf("%q is not a valid killmail id", args)
+	}
+
+	killmail, err := s.killmail.FullKillmail(ctx, uint(id), true)
+	if err != nil {
+		return nil, errors.Errorf("killmail %d could not be found", id)
+	}
+
+	return (&slackNotifier{config: s.config}).blocks(killmail), nil
+
+}
+
+func (s *service) slashWho(ctx context.Context, args string) ([]goslack.Block, error) {
+
+	if args == "" {
+		return nil, errors.Errorf("a name is required. %s", slashUsage)
+	}
+
+	entities, err := s.search.Fetch(ctx, args)
+	if err != nil {
+		return nil, errors.Errorf("failed to search for %q", args)
+	}
+
+	if len(entities) == 0 {
+		return nil, errors.Errorf("nothing matched %q", args)
+	}
+
+	if len(entities) > slashResults {
+		entities = entities[:slashResults]
+	}
+
+	blocks := make([]goslack.Block, 0, len(entities))
+	for _, entity := range entities {
+		var accessory *goslack.Accessory
+		if entity.Image != "" {
+			accessory = goslack.NewAccessory(goslack.NewImageBlockElement(entity.Image, entity.Name))
+		}
+
+		blocks = append(blocks, goslack.NewSectionBlock(
+			goslack.NewTextBlockObject(
+				goslack.MarkdownType,
+				fmt.Sprintf("*<%s|%s>*\n%s", s.entityURL(entity), entity.Name, strings.TrimSuffix(entity.Type, "s")),
+				false, false,
+			),
+			nil,
+			accessory,
+		))
+	}
+
+	return blocks, nil
+
+}
+
+// slashTop lists the most valuable kills of the best match for the name, or of everyone when no name is given
+func (s *service) slashTop(ctx context.Context, args string) ([]goslack.Block, error) {
+
+	var (
+		column = "none"
+		id     uint64
+		title  = fmt.Sprintf("*Most valuable kills of the last %d days*", slashTopAge)
+	)
+
+	if args != "" {
+		entities, err := s.search.Fetch(ctx, args)
+		if err != nil {
+			return nil, errors.Errorf("failed to search for %q", args)
+		}
+
+		if len(entities) == 0 {
+			return nil, errors.Errorf("nothing matched %q", args)
+		}
+
+		entity := entities[0]
+
+		var ok bool
+		if column, ok = topColumns[entity.Type]; !ok {
+			return nil, errors.Errorf("top kills are not available for %s", entity.Type)
+		}
+
+		id = entity.ID
+		title = fmt.Sprintf("*Most valuable kills of <%s|%s> in the last %d days*", s.entityURL(entity), entity.Name, slashTopAge)
+	}
+
+	killmails, err := s.killmail.MostValuable(ctx, column, id, slashTopAge, slashResults)
+	if err != nil {
+		return nil, errors.New("failed to fetch top kills")
+	}
+
+	if len(killmails) == 0 {
+		return nil, errors.Errorf("no kills found in the last %d days", slashTopAge)
+	}
+
+	lines := make([]string, 0, len(killmails))
+	for i, killmail := range killmails {
+		lines = append(lines, fmt.Sprintf(
+			"%d. <%s/kill/%d|%s> in %s, %s ISK",
+			i+1,
+			s.config.SlackActionBaseURL,
+			killmail.ID,
+			s.shipName(ctx, killmail),
+			s.systemName(ctx, killmail),
+			tools.AbbreviateNumber(killmail.TotalValue),
+		))
+	}
+
+	return []goslack.Block{
+		goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, title, false, false), nil, nil),
+		goslack.NewDividerBlock(),
+		goslack.NewSectionBlock(goslack.NewTextBlockObject(goslack.MarkdownType, strings.Join(lines, "\n"), false, false), nil, nil),
+	}, nil
+
+}
+
+func (s *service) shipName(ctx context.Context, killmail *neo.Killmail) string {
+
+	if killmail.Victim == nil {
+		return "Unknown Ship"
+	}
+
+	ship, err := s.universe.Type(ctx, killmail.Victim.ShipTypeID)
+	if err != nil {
+		return strconv.FormatUint(uint64(killmail.Victim.ShipTypeID), 10)
+	}
+
+	return ship.Name
+
+}
+
+func (s *service) systemName(ctx context.Context, killmail *neo.Killmail) string {
+
+	system, err := s.universe.SolarSystem(ctx, killmail.SolarSystemID)
+	if err != nil {
+		return strconv.FormatUint(uint64(killmail.SolarSystemID), 10)
+	}
+
+	return system.Name
+
+}
+
+// entityURL links to the page of a searchable entity. Types are listed on the site as ships
+func (s *service) entityURL(entity neo.SearchableEntity) string {
+
+	path := entity.Type
+	if path == "types" {
+		path = "ships"
+	}
+
+	return fmt.Sprintf("%s/%s/%d", s.config.SlackActionBaseURL, path, entity.ID)
+
+}
+
+func ephemeral(text string) *goslack.Msg {
+	return &goslack.Msg{
+		ResponseType: goslack.ResponseTypeEphemeral,
+		Text:         text,
+	}
+}
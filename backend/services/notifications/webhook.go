@@ -0,0 +1,45 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/eveisesi/neo"
+)
+
+// WebhookSignatureHeader carries the hex encoded HMAC-SHA256 of the request body, keyed with the destination
+// secret and prefixed with sha256=. Receivers should compute the same digest and compare it in constant time
+const WebhookSignatureHeader = "X-Neo-Signature"
+
+// webhookNotifier posts the full killmail as JSON to any HTTP endpoint
+type webhookNotifier struct{}
+
+func (n *webhookNotifier) Request(ctx context.Context, destination neo.NotificationDestination, killmail *neo.Killmail) (*http.Request, error) {
+
+	b, err := json.Marshal(killmail)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, destination.URL, bytes.NewBuffer(b))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookSignatureHeader, "sha256="+sign(destination.Secret, b))
+
+	return req, nil
+
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,54 @@
+package notifications
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/eveisesi/neo"
+)
+
+func TestSign(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		secret string
+		body   string
+		want   string
+	}{
+		{"known vector", "key", "The quick brown fox jumps over the lazy dog", "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"},
+		{"json body", "secret", `{"id":1}`, "03def589620c813f198fd03d7967e292b163ef0435ebf43071ce0e9519763cb7"},
+		{"other secret", "other", `{"id":1}`, "d746414f357e2109d640fadd8bc1aafcc0ea73b8f66c5570fb5e9a580f40a5c7"},
+		{"empty secret and body", "", "", "b613679a0814d9ec772f95d778c35fc5ff1697c493715653c6c712144292c5ad"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sign(tt.secret, []byte(tt.body)); got != tt.want {
+				t.Errorf("sign() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+}
+
+func TestWebhookRequestSignsBody(t *testing.T) {
+
+	destination := neo.NotificationDestination{Type: neo.NotificationDestinationWebhook, URL: "https://example.com/hook", Secret: "secret"}
+
+	req, err := new(webhookNotifier).Request(context.Background(), destination, &neo.Killmail{ID: 1})
+	if err != nil {
+		t.Fatalf("Request() error = %v", err)
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("failed to read request body: %v", err)
+	}
+
+	want := "sha256=" + sign(destination.Secret, body)
+	if got := req.Header.Get(WebhookSignatureHeader); got != want {
+		t.Errorf("%s = %s, want %s", WebhookSignatureHeader, got, want)
+	}
+
+}
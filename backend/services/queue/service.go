@@ -0,0 +1,255 @@
+package queue
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Service is a work queue backed by Redis Streams consumer groups. Messages stay pending against the consumer
+// that read them until they are acknowledged. Messages that remain pending for longer than the visibility timeout
+// are reclaimed by the next consumer to read from the stream, giving at-least-once delivery across replicas
+type Service interface {
+	Publish(ctx context.Context, stream string, payloads ...[]byte) error
+	Consume(ctx context.Context, stream string, count int64) ([]*Message, error)
+	Poll(ctx context.Context, stream string, count int64) ([]*Message, error)
+	Ack(ctx context.Context, stream string, ids ...string) error
+	Len(ctx context.Context, stream string) (int64, error)
+	Pending(ctx context.Context, stream string) (int64, error)
+}
+
+type (
+	service struct {
+		redis      *redis.Client
+		logger     *logrus.Logger
+		group      string
+		consumer   string
+		visibility time.Duration
+		block      time.Duration
+
+		groups sync.Map
+	}
+
+	Message struct {
+		ID      string
+		Stream  string
+		Payload []byte
+	}
+)
+
+const payloadField = "payload"
+
+func NewService(redis *redis.Client, logger *logrus.Logger, group, consumer string, visibility time.Duration) Service {
+	return &service{
+		redis:      redis,
+		logger:     logger,
+		group:      group,
+		consumer:   consumer,
+		visibility: visibility,
+		block:      time.Second * 2,
+	}
+}
+
+func (s *service) Publish(ctx context.Context, stream string, payloads ...[]byte) error {
+
+	if len(payloads) == 0 {
+		return nil
+	}
+
+	pipe := s.redis.Pipeline()
+	for _, payload := range payloads {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: stream,
+			Values: map[string]interface{}{payloadField: payload},
+		})
+	}
+
+	_, err := pipe.Exec(ctx)
+
+	return errors.Wrapf(err, "failed to publish to stream %s", stream)
+
+}
+
+// Consume returns up to count messages for this consumer. Messages abandoned by other consumers are reclaimed
+// first, then new messages are read from the stream, blocking for a short period if the stream is empty
+func (s *service) Consume(ctx context.Context, stream string, count int64) ([]*Message, error) {
+	return s.consume(ctx, stream, count, s.block)
+}
+
+// Poll behaves like Consume but returns immediately if the stream is empty
+func (s *service) Poll(ctx context.Context, stream string, count int64) ([]*Message, error) {
+	return s.consume(ctx, stream, count, -1)
+}
+
+func (s *service) consume(ctx context.Context, stream string, count int64, block time.Duration) ([]*Message, error) {
+
+	err := s.ensureGroup(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.reclaim(ctx, stream, count)
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(messages)) >= count {
+		return messages, nil
+	}
+
+	results, err := s.redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    s.group,
+		Consumer: s.consumer,
+		Streams:  []string{stream, ">"},
+		Count:    count - int64(len(messages)),
+		Block:    block,
+	}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return messages, errors.Wrapf(err, "failed to read from stream %s", stream)
+	}
+
+	for _, result := range results {
+		messages = append(messages, s.toMessages(ctx, stream, result.Messages)...)
+	}
+
+	return messages, nil
+
+}
+
+// Ack acknowledges the messages and removes them from the stream so that the length of the stream
+// reflects the work that is outstanding
+func (s *service) Ack(ctx context.Context, stream string, ids ...string) error {
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	pipe := s.redis.TxPipeline()
+	pipe.XAck(ctx, stream, s.group, ids...)
+	pipe.XDel(ctx, stream, ids...)
+	_, err := pipe.Exec(ctx)
+
+	return errors.Wrapf(err, "failed to ack messages on stream %s", stream)
+
+}
+
+func (s *service) Len(ctx context.Context, stream string) (int64, error) {
+	return s.redis.XLen(ctx, stream).Result()
+}
+
+func (s *service) Pending(ctx context.Context, stream string) (int64, error) {
+
+	err := s.ensureGroup(ctx, stream)
+	if err != nil {
+		return 0, err
+	}
+
+	pending, err := s.redis.XPending(ctx, stream, s.group).Result()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch pending count for stream %s", stream)
+	}
+
+	return pending.Count, nil
+
+}
+
+func (s *service) reclaim(ctx context.Context, stream string, count int64) ([]*Message, error) {
+
+	pending, err := s.redis.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: stream,
+		Group:  s.group,
+		Start:  "-",
+		End:    "+",
+		Count:  count,
+	}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errors.Wrapf(err, "failed to fetch pending entries for stream %s", stream)
+	}
+
+	ids := make([]string, 0, len(pending))
+	for _, entry := range pending {
+		if entry.Idle < s.visibility {
+			continue
+		}
+		ids = append(ids, entry.ID)
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	claimed, err := s.redis.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   stream,
+		Group:    s.group,
+		Consumer: s.consumer,
+		MinIdle:  s.visibility,
+		Messages: ids,
+	}).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errors.Wrapf(err, "failed to claim pending entries for stream %s", stream)
+	}
+
+	if len(claimed) > 0 {
+		s.logger.WithContext(ctx).WithFields(logrus.Fields{
+			"stream":   stream,
+			"consumer": s.consumer,
+			"claimed":  len(claimed),
+		}).Warn("reclaimed messages that exceeded the visibility timeout")
+	}
+
+	return s.toMessages(ctx, stream, claimed), nil
+
+}
+
+func (s *service) ensureGroup(ctx context.Context, stream string) error {
+
+	if _, ok := s.groups.Load(stream); ok {
+		return nil
+	}
+
+	err := s.redis.XGroupCreateMkStream(ctx, stream, s.group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return errors.Wrapf(err, "failed to create consumer group for stream %s", stream)
+	}
+
+	s.groups.Store(stream, true)
+
+	return nil
+
+}
+
+// toMessages converts stream entries into messages. Entries without a payload can never be processed
+// so they are acknowledged immediately rather than being reclaimed forever
+func (s *service) toMessages(ctx context.Context, stream string, xmessages []redis.XMessage) []*Message {
+
+	messages := make([]*Message, 0, len(xmessages))
+	for _, xmessage := range xmessages {
+		payload, ok := xmessage.Values[payloadField].(string)
+		if !ok {
+			entry := s.logger.WithContext(ctx).WithFields(logrus.Fields{
+				"stream": stream,
+				"id":     xmessage.ID,
+			})
+			entry.Error("stream entry is missing payload field, discarding")
+			err := s.Ack(ctx, stream, xmessage.ID)
+			if err != nil {
+				entry.WithError(err).Error("failed to discard stream entry")
+			}
+			continue
+		}
+
+		messages = append(messages, &Message{
+			ID:      xmessage.ID,
+			Stream:  stream,
+			Payload: []byte(payload),
+		})
+	}
+
+	return messages
+
+}
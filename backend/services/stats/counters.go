@@ -0,0 +1,146 @@
+package stats
+
+import (
+	"github.com/eveisesi/neo"
+)
+
+// involvement is an entity that a killmail counts towards, either as a kill or as a loss
+type involvement struct {
+	entity neo.StatEntity
+	id     uint64
+	lost   bool
+}
+
+// counters returns the counters of each frequency that the killmail adds to. An entity is counted once per
+// killmail, however many of the attackers belong to it. Locations count the killmail as a kill. Kills also add to
+// the solo kill and attacker counters. NPC killmails are not counted. When entity is not empty only the counters of that entity type are returned
+func counters(killmail *neo.Killmail, entity neo.StatEntity, frequencies ...neo.StatFrequency) []*neo.Stat {
+
+	if killmail.IsNPC {
+		return nil
+	}
+
+	seen := make(map[involvement]bool)
+	involvements := make([]involvement, 0)
+	involve := func(e neo.StatEntity, id uint64, lost bool) {
+		if id == 0 || (entity != "" && e != entity) {
+			return
+		}
+
+		i := involvement{entity: e, id: id, lost: lost}
+		if seen[i] {
+			return
+		}
+
+		seen[i] = true
+		involvements = append(involvements, i)
+	}
+
+	involve(neo.StatEntitySystem, uint64(killmail.SolarSystemID), false)
+	involve(neo.StatEntityConstellation, uint64(killmail.ConstellationID), false)
+	involve(neo.StatEntityRegion, uint64(killmail.RegionID), false)
+
+	if victim := killmail.Victim; victim != nil {
+		involve(neo.StatEntityCharacter, uint64Value(victim.CharacterID), true)
+		involve(neo.StatEntityCorporation, uintValue(victim.CorporationID), true)
+		involve(neo.StatEntityAlliance, uintValue(victim.AllianceID), true)
+		involve(neo.StatEntityShip, uint64(victim.ShipTypeID), true)
+		involve(neo.StatEntityShipGroup, uint64(victim.ShipGroupID), true)
+	}
+
+	for _, attacker := range killmail.Attackers {
+		involve(neo.StatEntityCharacter, uint64Value(attacker.CharacterID), false)
+		involve(neo.StatEntityCorporation, uintValue(attacker.CorporationID), false)
+		involve(neo.StatEntityAlliance, uintValue(attacker.AllianceID), false)
+		involve(neo.StatEntityShip, uintValue(attacker.ShipTypeID), false)
+		involve(neo.StatEntityShipGroup, uintValue(attacker.ShipGroupID), false)
+	}
+
+	pilots := playerAttackers(killmail)
+
+	stats := make([]*neo.Stat, 0, len(involvements)*len(frequencies)*4)
+	for _, i := range involvements {
+		ships, isk := neo.StatCategoryShipsKilled, neo.StatCategoryISKKilled
+		if i.lost {
+			ships, isk = neo.StatCategoryShipsLost, neo.StatCategoryISKLost
+		}
+
+		for _, frequency := range frequencies {
+			date := frequency.Truncate(killmail.KillmailTime)
+			stat := func(category neo.StatCategory, value float64) *neo.Stat {
+				return &neo.Stat{EntityID: i.id, EntityType: i.entity, Category: category, Frequency: frequency, Date: date, Value: value}
+			}
+
+			stats = append(stats, stat(ships, 1), stat(isk, killmail.TotalValue))
+			if i.lost {
+				continue
+			}
+
+			if killmail.IsSolo {
+				stats = append(stats, stat(neo.StatCategorySoloKills, 1))
+			}
+			if pilots > 0 {
+				stats = append(stats, stat(neo.StatCategoryAttackers, float64(pilots)))
+			}
+		}
+	}
+
+	return stats
+
+}
+
+// counter identifies the counter that a stat adds to
+type counter struct {
+	entity    neo.StatEntity
+	id        uint64
+	category  neo.StatCategory
+	frequency neo.StatFrequency
+	date      int64
+}
+
+// merge sums the stats that add to the same counter, so that each counter is only written once
+func merge(stats []*neo.Stat) []*neo.Stat {
+
+	merged := make(map[counter]*neo.Stat)
+	out := make([]*neo.Stat, 0)
+	for _, stat := range stats {
+		key := counter{stat.EntityType, stat.EntityID, stat.Category, stat.Frequency, stat.Date.Unix()}
+		if existing, ok := merged[key]; ok {
+			existing.Value += stat.Value
+			continue
+		}
+
+		merged[key] = stat
+		out = append(out, stat)
+	}
+
+	return out
+
+}
+
+func playerAttackers(killmail *neo.Killmail) int {
+
+	pilots := 0
+	for _, attacker := range killmail.Attackers {
+		if uint64Value(attacker.CharacterID) > 0 {
+			pilots++
+		}
+	}
+
+	return pilots
+
+}
+
+func uintValue(v *uint) uint64 {
+	if v == nil {
+		return 0
+	}
+	return uint64(*v)
+}
+
+func uint64Value(v *uint64) uint64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
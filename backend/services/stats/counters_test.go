@@ -0,0 +1,182 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eveisesi/neo"
+)
+
+func testKillmail() *neo.Killmail {
+
+	victimCharacter := uint64(1)
+	victimCorporation := uint(10)
+	firstAttacker, secondAttacker := uint64(2), uint64(3)
+	attackerCorporation := uint(20)
+	attackerShip, attackerGroup := uint(600), uint(26)
+
+	return &neo.Killmail{
+		ID:              100,
+		KillmailTime:    time.Date(2020, 6, 15, 18, 45, 0, 0, time.UTC),
+		SolarSystemID:   30000142,
+		ConstellationID: 20000020,
+		RegionID:        10000002,
+		TotalValue:      500,
+		Victim: &neo.KillmailVictim{
+			CharacterID:   &victimCharacter,
+			CorporationID: &victimCorporation,
+			ShipTypeID:    587,
+			ShipGroupID:   25,
+		},
+		Attackers: []*neo.KillmailAttacker{
+			{CharacterID: &firstAttacker, CorporationID: &attackerCorporation, ShipTypeID: &attackerShip, ShipGroupID: &attackerGroup},
+			{CharacterID: &secondAttacker, CorporationID: &attackerCorporation, ShipTypeID: &attackerShip, ShipGroupID: &attackerGroup},
+			// NPC attackers have no character and do not count towards the attackers counter
+			{CorporationID: &attackerCorporation},
+		},
+	}
+
+}
+
+// findStat returns the value of the counter and whether it was found
+func findStat(stats []*neo.Stat, entity neo.StatEntity, id uint64, category neo.StatCategory, frequency neo.StatFrequency) (float64, int) {
+
+	var value float64
+	found := 0
+	for _, stat := range stats {
+		if stat.EntityType == entity && stat.EntityID == id && stat.Category == category && stat.Frequency == frequency {
+			value += stat.Value
+			found++
+		}
+	}
+
+	return value, found
+
+}
+
+func TestCounters(t *testing.T) {
+
+	killmail := testKillmail()
+	stats := counters(killmail, "", neo.StatFrequencyDaily)
+
+	tests := []struct {
+		name     string
+		entity   neo.StatEntity
+		id       uint64
+		category neo.StatCategory
+		want     float64
+		found    int
+	}{
+		{"system kill", neo.StatEntitySystem, 30000142, neo.StatCategoryShipsKilled, 1, 1},
+		{"constellation isk", neo.StatEntityConstellation, 20000020, neo.StatCategoryISKKilled, 500, 1},
+		{"region attackers", neo.StatEntityRegion, 10000002, neo.StatCategoryAttackers, 2, 1},
+		{"victim character loss", neo.StatEntityCharacter, 1, neo.StatCategoryShipsLost, 1, 1},
+		{"victim corporation isk lost", neo.StatEntityCorporation, 10, neo.StatCategoryISKLost, 500, 1},
+		{"victim ship loss", neo.StatEntityShip, 587, neo.StatCategoryShipsLost, 1, 1},
+		{"victim group loss", neo.StatEntityShipGroup, 25, neo.StatCategoryShipsLost, 1, 1},
+		{"victim has no attackers counter", neo.StatEntityCharacter, 1, neo.StatCategoryAttackers, 0, 0},
+		{"attacker character kill", neo.StatEntityCharacter, 2, neo.StatCategoryShipsKilled, 1, 1},
+		{"attacker corporation counted once", neo.StatEntityCorporation, 20, neo.StatCategoryShipsKilled, 1, 1},
+		{"attacker ship counted once", neo.StatEntityShip, 600, neo.StatCategoryISKKilled, 500, 1},
+		{"no solo kills", neo.StatEntityCharacter, 2, neo.StatCategorySoloKills, 0, 0},
+		{"no alliance", neo.StatEntityAlliance, 0, neo.StatCategoryShipsKilled, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, found := findStat(stats, tt.entity, tt.id, tt.category, neo.StatFrequencyDaily)
+			if found != tt.found || value != tt.want {
+				t.Errorf("counter = %v (found %d times), want %v (found %d times)", value, found, tt.want, tt.found)
+			}
+		})
+	}
+
+	// 8 kill involvements with ships, isk and attackers counters and 4 loss involvements with ships and isk counters
+	if len(stats) != 32 {
+		t.Errorf("len(counters()) = %d, want 32", len(stats))
+	}
+
+	for _, stat := range stats {
+		if want := time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC); !stat.Date.Equal(want) {
+			t.Fatalf("counter date = %s, want %s", stat.Date, want)
+		}
+	}
+
+}
+
+func TestCountersVariants(t *testing.T) {
+
+	solo := testKillmail()
+	solo.IsSolo = true
+	solo.Attackers = solo.Attackers[:1]
+
+	npc := testKillmail()
+	npc.IsNPC = true
+
+	withoutVictim := testKillmail()
+	withoutVictim.Victim = nil
+
+	tests := []struct {
+		name        string
+		killmail    *neo.Killmail
+		entity      neo.StatEntity
+		frequencies []neo.StatFrequency
+		want        int
+	}{
+		{"npc killmails are not counted", npc, "", neo.AllStatFrequencys, 0},
+		{"entity filter", testKillmail(), neo.StatEntityCharacter, []neo.StatFrequency{neo.StatFrequencyDaily}, 3 + 3 + 2},
+		{"every frequency", testKillmail(), "", neo.AllStatFrequencys, 32 * len(neo.AllStatFrequencys)},
+		{"solo kills add a counter", solo, neo.StatEntityCharacter, []neo.StatFrequency{neo.StatFrequencyDaily}, 4 + 2},
+		{"killmail without victim", withoutVictim, "", []neo.StatFrequency{neo.StatFrequencyDaily}, 24},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := counters(tt.killmail, tt.entity, tt.frequencies...); len(got) != tt.want {
+				t.Errorf("len(counters()) = %d, want %d", len(got), tt.want)
+			}
+		})
+	}
+
+}
+
+func TestMerge(t *testing.T) {
+
+	day := time.Date(2020, 6, 15, 0, 0, 0, 0, time.UTC)
+	stat := func(id uint64, category neo.StatCategory, date time.Time, value float64) *neo.Stat {
+		return &neo.Stat{EntityType: neo.StatEntityCharacter, EntityID: id, Category: category, Frequency: neo.StatFrequencyDaily, Date: date, Value: value}
+	}
+
+	tests := []struct {
+		name  string
+		stats []*neo.Stat
+		want  []float64
+	}{
+		{"empty", []*neo.Stat{}, []float64{}},
+		{"distinct counters", []*neo.Stat{stat(1, neo.StatCategoryShipsKilled, day, 1), stat(2, neo.StatCategoryShipsKilled, day, 1)}, []float64{1, 1}},
+		{"same counter is summed", []*neo.Stat{stat(1, neo.StatCategoryISKKilled, day, 100), stat(1, neo.StatCategoryISKKilled, day, 50)}, []float64{150}},
+		{"other category", []*neo.Stat{stat(1, neo.StatCategoryISKKilled, day, 100), stat(1, neo.StatCategoryISKLost, day, 50)}, []float64{100, 50}},
+		{"other date", []*neo.Stat{stat(1, neo.StatCategoryShipsKilled, day, 1), stat(1, neo.StatCategoryShipsKilled, day.AddDate(0, 0, 1), 1)}, []float64{1, 1}},
+		{"first occurrence keeps its position", []*neo.Stat{
+			stat(1, neo.StatCategoryShipsKilled, day, 1),
+			stat(2, neo.StatCategoryShipsKilled, day, 1),
+			stat(1, neo.StatCategoryShipsKilled, day, 1),
+		}, []float64{2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := merge(tt.stats)
+			if len(got) != len(tt.want) {
+				t.Fatalf("len(merge()) = %d, want %d", len(got), len(tt.want))
+			}
+
+			for i, stat := range got {
+				if stat.Value != tt.want[i] {
+					t.Errorf("merge()[%d].Value = %v, want %v", i, stat.Value, tt.want[i])
+				}
+			}
+		})
+	}
+
+}
@@ -0,0 +1,245 @@
+package stats
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+	"github.com/sirkon/go-format"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// maxLeaderboardEntries is the number of entries computed and cached for every leaderboard. Smaller limits are
+	// served from the same entries
+	maxLeaderboardEntries = 100
+	// globalLeaderboardDuration outlives the hourly cron that rebuilds the global leaderboards, so that they do not
+	// expire between runs
+	globalLeaderboardDuration = time.Hour * 2
+	scopedLeaderboardDuration = time.Minute * 10
+)
+
+// leaderboardCategories are the categories of the global leaderboards precomputed by BuildLeaderboards
+var leaderboardCategories = []neo.StatEntity{
+	neo.StatEntityCharacter,
+	neo.StatEntityCorporation,
+	neo.StatEntityAlliance,
+	neo.StatEntityShip,
+	neo.StatEntitySystem,
+}
+
+// Leaderboard returns the top entities of the category over the period. Leaderboards are global when scope is
+// empty. Global leaderboards are precomputed by BuildLeaderboards, scoped leaderboards are computed on demand and
+// cached for a few minutes
+func (s *service) Leaderboard(ctx context.Context, category, scope neo.StatEntity, scopeID uint64, period neo.LeaderboardPeriod, metric neo.LeaderboardMetric, limit int) (*neo.Leaderboard, error) {
+
+	if !period.IsValid() {
+		return nil, errors.Errorf("%s is not a valid leaderboard period", period)
+	}
+
+	if !metric.IsValid() {
+		return nil, errors.Errorf("%s is not a valid leaderboard metric", metric)
+	}
+
+	if limit < 1 || limit > maxLeaderboardEntries {
+		return nil, errors.Errorf("limit must be between 1 and %d", maxLeaderboardEntries)
+	}
+
+	if scope != "" && scopeID == 0 {
+		return nil, errors.New("scoped leaderboards require a scope id")
+	}
+
+	key := leaderboardKey(category, scope, scopeID, period, metric)
+	entry := s.logger.WithFields(logrus.Fields{
+		"key":   key,
+		"class": "Leaderboard",
+	})
+
+	leaderboard, err := s.cachedLeaderboard(ctx, key)
+	if err != nil {
+		entry.WithError(err).Error("failed to fetch leaderboard from cache")
+	}
+
+	if leaderboard == nil {
+		entry.Info("cache miss, building leaderboard")
+
+		leaderboard, err = s.buildLeaderboard(ctx, category, scope, scopeID, period, metric)
+		if err != nil {
+			return nil, err
+		}
+
+		duration := scopedLeaderboardDuration
+		if scope == "" {
+			duration = globalLeaderboardDuration
+		}
+
+		err = s.cacheLeaderboard(ctx, key, leaderboard, duration)
+		if err != nil {
+			entry.WithError(err).Error("failed to cache leaderboard")
+		}
+	}
+
+	if len(leaderboard.Entries) > limit {
+		leaderboard.Entries = leaderboard.Entries[:limit]
+	}
+
+	for _, e := range leaderboard.Entries {
+		e.Category = leaderboard.Category
+	}
+
+	return leaderboard, nil
+
+}
+
+// BuildLeaderboards precomputes every global leaderboard and returns the number of leaderboards built. A
+// leaderboard that fails to build is logged and skipped, leaving the previous one in the cache until it expires
+func (s *service) BuildLeaderboards(ctx context.Context) (int, error) {
+
+	built := 0
+	for _, category := range leaderboardCategories {
+		for _, metric := range neo.AllLeaderboardMetrics {
+			for _, period := range neo.AllLeaderboardPeriods {
+				entry := s.logger.WithContext(ctx).WithFields(logrus.Fields{
+					"category": category,
+					"metric":   metric,
+					"period":   period,
+				})
+
+				leaderboard, err := s.buildLeaderboard(ctx, category, "", 0, period, metric)
+				if err != nil {
+					entry.WithError(err).Error("failed to build leaderboard")
+					continue
+				}
+
+				err = s.cacheLeaderboard(ctx, leaderboardKey(category, "", 0, period, metric), leaderboard, globalLeaderboardDuration)
+				if err != nil {
+					entry.WithError(err).Error("failed to cache leaderboard")
+					continue
+				}
+
+				built++
+			}
+		}
+	}
+
+	if built == 0 {
+		return 0, errors.New("failed to build any leaderboard")
+	}
+
+	return built, nil
+
+}
+
+func (s *service) buildLeaderboard(ctx context.Context, category, scope neo.StatEntity, scopeID uint64, period neo.LeaderboardPeriod, metric neo.LeaderboardMetric) (*neo.Leaderboard, error) {
+
+	column, ok := leaderboardColumn(category)
+	if !ok {
+		return nil, errors.Errorf("leaderboards are not available for %s", category)
+	}
+
+	now := time.Now().UTC()
+
+	operators := []*neo.Operator{
+		neo.NewEqualOperator("isNPC", false),
+		neo.NewGreaterThanEqualToOperator("killmailTime", now.Truncate(time.Hour).AddDate(0, 0, -period.Days())),
+	}
+
+	var attackers []*neo.Operator
+	if scope != "" {
+		if scopeColumn, ok := scope.InvolvementColumn(); ok {
+			// The killmails are narrowed down before they are unwound to keep the aggregation on the index
+			operators = append(operators, neo.NewEqualOperator("attackers."+scopeColumn, scopeID))
+			attackers = append(attackers, neo.NewEqualOperator("attackers."+scopeColumn, scopeID))
+		} else if scopeColumn, ok := scope.LocationColumn(); ok {
+			operators = append(operators, neo.NewEqualOperator(scopeColumn, scopeID))
+		} else {
+			return nil, errors.Errorf("leaderboards cannot be scoped to %s", scope)
+		}
+	}
+
+	counts, err := s.killmails.KillmailCounts(ctx, column, attackers, metric == neo.LeaderboardMetricISKDestroyed, maxLeaderboardEntries, operators...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to aggregate leaderboard")
+	}
+
+	leaderboard := &neo.Leaderboard{
+		Category:    category,
+		Scope:       scope,
+		ScopeID:     scopeID,
+		Period:      period,
+		Metric:      metric,
+		Entries:     make([]*neo.LeaderboardEntry, 0, len(counts)),
+		GeneratedAt: now,
+	}
+
+	for _, count := range counts {
+		leaderboard.Entries = append(leaderboard.Entries, &neo.LeaderboardEntry{
+			ID:           count.ID,
+			Kills:        count.Killmails,
+			ISKDestroyed: count.TotalValue,
+		})
+	}
+
+	return leaderboard, nil
+
+}
+
+func (s *service) cachedLeaderboard(ctx context.Context, key string) (*neo.Leaderboard, error) {
+
+	data, err := s.redis.Get(ctx, key).Bytes()
+	if err != nil {
+		if err.Error() == neo.ErrRedisNil.Error() {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var leaderboard = new(neo.Leaderboard)
+	err = json.Unmarshal(data, leaderboard)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal leaderboard from cache")
+	}
+
+	return leaderboard, nil
+
+}
+
+func (s *service) cacheLeaderboard(ctx context.Context, key string, leaderboard *neo.Leaderboard, duration time.Duration) error {
+
+	data, err := json.Marshal(leaderboard)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal leaderboard for cache")
+	}
+
+	return s.redis.Set(ctx, key, data, duration).Err()
+
+}
+
+// leaderboardColumn returns the killmail column that entities of the category are credited with kills by
+func leaderboardColumn(category neo.StatEntity) (string, bool) {
+
+	if column, ok := category.InvolvementColumn(); ok {
+		return "attackers." + column, true
+	}
+
+	return category.LocationColumn()
+
+}
+
+func leaderboardKey(category, scope neo.StatEntity, scopeID uint64, period neo.LeaderboardPeriod, metric neo.LeaderboardMetric) string {
+
+	if scope == "" {
+		scope = "global"
+	}
+
+	return format.Formatm(neo.REDIS_LEADERBOARD, format.Values{
+		"category": category,
+		"metric":   metric,
+		"period":   period,
+		"scope":    scope,
+		"scopeID":  scopeID,
+	})
+
+}
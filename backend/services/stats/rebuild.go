@@ -0,0 +1,95 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const rebuildBatchSize = 500
+
+// Rebuild recomputes the counters of the entity type from the stored killmails that occurred on or after from and
+// returns the number of killmails counted. from is moved back to the start of its year so that the monthly and
+// yearly counters are rebuilt whole, after which the all-time counters are summed from the yearly counters.
+// The stats consumer should be stopped while a rebuild runs, otherwise the killmails it consumes in the meantime
+// are counted twice
+func (s *service) Rebuild(ctx context.Context, entity neo.StatEntity, from time.Time) (int64, error) {
+
+	if !entity.IsValid() {
+		return 0, errors.Errorf("%s is not a valid stat entity", entity)
+	}
+
+	from = neo.StatFrequencyYearly.Truncate(from)
+	frequencies := []neo.StatFrequency{neo.StatFrequencyDaily, neo.StatFrequencyMonthly, neo.StatFrequencyYearly}
+
+	values := make([]neo.OpValue, 0, len(frequencies))
+	for _, frequency := range frequencies {
+		values = append(values, frequency)
+	}
+
+	err := s.DeleteStats(
+		ctx,
+		neo.NewEqualOperator("entityType", entity),
+		neo.NewInOperator("frequency", values),
+		neo.NewGreaterThanEqualToOperator("date", from),
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete existing stats")
+	}
+
+	var (
+		after   uint
+		counted int64
+	)
+
+	for {
+		killmails, err := s.killmails.Killmails(
+			ctx,
+			neo.NewGreaterThanEqualToOperator("killmailTime", from),
+			neo.NewGreaterThanOperator("id", after),
+			neo.NewOrderOperator("id", neo.SortAsc),
+			neo.NewLimitOperator(rebuildBatchSize),
+		)
+		if err != nil {
+			return counted, errors.Wrap(err, "failed to fetch killmails to rebuild stats")
+		}
+
+		if len(killmails) == 0 {
+			break
+		}
+
+		stats := make([]*neo.Stat, 0)
+		for _, killmail := range killmails {
+			stats = append(stats, counters(killmail, entity, frequencies...)...)
+		}
+
+		err = s.IncrementStats(ctx, merge(stats))
+		if err != nil {
+			return counted, errors.Wrap(err, "failed to increment stats")
+		}
+
+		counted += int64(len(killmails))
+		after = killmails[len(killmails)-1].ID
+
+		s.logger.WithContext(ctx).WithFields(logrus.Fields{
+			"entity":  entity,
+			"after":   after,
+			"counted": counted,
+		}).Info("stats rebuild batch complete")
+
+		if len(killmails) < rebuildBatchSize {
+			break
+		}
+	}
+
+	err = s.RollupAlltimeStats(ctx, entity)
+	if err != nil {
+		return counted, errors.Wrap(err, "failed to rollup all-time stats")
+	}
+
+	return counted, nil
+
+}
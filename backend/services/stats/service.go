@@ -3,440 +3,147 @@ package stats
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
-	"github.com/eveisesi/neo/services/killmail"
-
 	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/services/queue"
 	"github.com/go-redis/redis/v8"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type Service interface {
-	Run() error
-	// Recalculate(id int64, entityType neo.StatEntity, date time.Time) error
+	Run(ctx context.Context)
+	Rebuild(ctx context.Context, entity neo.StatEntity, from time.Time) (int64, error)
+	EntityStats(ctx context.Context, entity neo.StatEntity, id uint64, period neo.StatPeriod) (*neo.EntityStats, error)
+
+	// Leaderboards
+	Leaderboard(ctx context.Context, category, scope neo.StatEntity, scopeID uint64, period neo.LeaderboardPeriod, metric neo.LeaderboardMetric, limit int) (*neo.Leaderboard, error)
+	BuildLeaderboards(ctx context.Context) (int, error)
+
 	neo.StatsRepository
 }
 
 type service struct {
 	redis    *redis.Client
+	queue    queue.Service
 	logger   *logrus.Logger
 	newrelic *newrelic.Application
 
-	killmail killmail.Service
+	killmails neo.KillmailRepository
 
 	neo.StatsRepository
 }
 
-func NewService(redis *redis.Client, logger *logrus.Logger, newrelic *newrelic.Application, killmail killmail.Service, stats neo.StatsRepository) Service {
+// countedTTL is how long a killmail is remembered as counted. It only needs to outlive any redelivery of the message
+const countedTTL = time.Hour * 24 * 7
+
+func NewService(redis *redis.Client, queue queue.Service, logger *logrus.Logger, newrelic *newrelic.Application, killmails neo.KillmailRepository, stats neo.StatsRepository) Service {
 	return &service{
 		redis,
+		queue,
 		logger,
 		newrelic,
-		killmail,
+		killmails,
 		stats,
 	}
 }
 
-func (s *service) Run() error {
-
-	ctx := context.Background()
+// Run consumes the killmails queued by the importer and adds them to the counters of every entity involved
+func (s *service) Run(ctx context.Context) {
 
 	for {
-		entry := s.logger
-		count, err := s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_STATS, "-inf", "+inf").Result()
+		entry := s.logger.WithContext(ctx)
+		messages, err := s.queue.Consume(ctx, neo.QUEUES_KILLMAIL_STATS, 5)
 		if err != nil {
-			entry.WithError(err).Error("unable to determine count of message queue")
+			entry.WithError(err).Error("unable to consume messages from stats queue")
 			time.Sleep(time.Second * 2)
 			continue
 		}
 
-		if count == 0 {
+		if len(messages) == 0 {
 			entry.Info("stats queue is empty")
-			time.Sleep(time.Second)
 			continue
 		}
 
-		results, err := s.redis.ZPopMax(ctx, neo.QUEUES_KILLMAIL_STATS, 5).Result()
-		if err != nil {
-			entry.WithError(err).Fatal("unable to retrieve hashes from queue")
-		}
-
-		for _, result := range results {
+		for _, result := range messages {
 			var message neo.Message
-			err := json.Unmarshal([]byte(result.Member.(string)), &message)
+			err := json.Unmarshal(result.Payload, &message)
 			if err != nil {
-				s.logger.WithError(err).WithField("membver", result.Member).Error("failed to unmarshal queue payload")
+				s.logger.WithError(err).WithField("member", string(result.Payload)).Error("failed to unmarshal queue payload")
+			} else if err = s.processMessage(message); err != nil {
+				// The message is left pending so that it is redelivered once the visibility timeout expires
+				s.logger.WithError(err).WithField("id", message.ID).Error("failed to process stats message")
 				continue
 			}
 
-			// s.processMessage(message)
+			err = s.queue.Ack(ctx, neo.QUEUES_KILLMAIL_STATS, result.ID)
+			if err != nil {
+				s.logger.WithError(err).WithField("message_id", result.ID).Error("failed to ack stats message")
+			}
 		}
 	}
 
 }
 
-// func (s *service) Recalculate(id int64, entityType neo.StatEntity, date time.Time) error {
-
-// 	txn := s.newrelic.StartTransaction("recalculate stats")
-// 	txn.AddAttribute("id", id)
-// 	txn.AddAttribute("type", entityType.String())
-// 	txn.AddAttribute("date", date.Format("YYYYMMDD"))
-// 	ctx := newrelic.NewContext(context.Background(), txn)
-
-// 	err := s.DeleteStats(ctx)
-
-// 	// err := s.DeleteRecordsByTypeAfterDate(ctx, id, entityType, date)
-// 	if err != nil {
-// 		return fmt.Errorf("failed to delete existing stats: %w", err)
-// 	}
-// 	i := 1
-// 	for {
-// 		killmails, err := s.killmail.KillmailsByShipID(ctx, uint64(id), i)
-// 		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
-// 			return fmt.Errorf("failed to fetch killmails to recalculate stats")
-// 		}
-// 		if errors.Is(err, mongo.ErrNoDocuments) {
-// 			return nil
-// 		}
-
-// 		spew.Dump(len(killmails))
-// 		i++
-// 		time.Sleep(time.Second)
-
-// 	}
-
-// 	return nil
-
-// }
-
-// func (s *service) processMessage(msg neo.Message) {
-
-// 	txn := s.newrelic.StartTransaction("process stats message")
-// 	defer txn.End()
-
-// 	ctx := newrelic.NewContext(context.Background(), txn)
-
-// 	entry := s.logger.WithContext(ctx).WithFields(logrus.Fields{
-// 		"id":   msg.ID,
-// 		"hash": msg.Hash,
-// 	})
-
-// 	killmail, err := s.killmail.FullKillmail(ctx, msg.ID, false)
-// 	if err != nil {
-// 		entry.WithError(err).Error("failed to fetch full killmail for stats")
-// 		return
-// 	}
-// 	if killmail.IsNPC {
-// 		return
-// 	}
-
-// 	stats := make([]*neo.Stat, 0)
-// 	stats = append(stats, s.location(killmail)...)
-// 	stats = append(stats, s.victim(killmail)...)
-// 	stats = append(stats, s.attackers(killmail)...)
+// processMessage adds the killmail to its counters. An error is only returned when the killmail could not be read or
+// marked as counted and the message should be redelivered
+func (s *service) processMessage(msg neo.Message) error {
 
-// 	chunks := chunkSliceStats(stats, 100)
-// 	for _, _ = range chunks {
-// 		err = nil
-// 		if err != nil {
-// 			entry.WithError(err).Error("encountered error calculating stats")
-// 			return
-// 		}
-// 	}
+	txn := s.newrelic.StartTransaction("process stats message")
+	defer txn.End()
 
-// 	entry.Info("stats calculated successfully")
+	ctx := newrelic.NewContext(context.Background(), txn)
 
-// }
+	entry := s.logger.WithContext(ctx).WithFields(logrus.Fields{
+		"id":   msg.ID,
+		"hash": msg.Hash,
+	})
 
-// func chunkSliceStats(slice []*neo.Stat, size int) [][]*neo.Stat {
-
-// 	var chunk = make([][]*neo.Stat, 0)
-// 	if len(slice) <= size {
-// 		chunk = append(chunk, slice)
-// 		slice = nil
-// 		return chunk
-// 	}
-
-// 	for x := 0; x < len(slice); x += size {
-// 		end := x + size
-
-// 		if end > len(slice) {
-// 			end = len(slice)
-// 		}
-
-// 		chunk = append(chunk, slice[x:end])
-// 	}
-
-// 	slice = nil
-
-// 	return chunk
-
-// }
-
-// func (s *service) date(t time.Time) *neo.Date {
-// 	return &neo.Date{Time: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
-// }
-
-// func (s *service) location(killmail *neo.Killmail) []*neo.Stat {
-
-// 	date := s.date(killmail.KillmailTime)
-// 	stats := make([]*neo.Stat, 0)
-// 	stats = append(stats, &neo.Stat{
-// 		EntityID:   uint64(killmail.SolarSystemID),
-// 		EntityType: neo.StatEntitySystem,
-// 		Category:   neo.StatCategoryShipsKilled,
-// 		Frequency:  neo.StatFrequencyDaily,
-// 		Date:       date,
-// 		Value:      1,
-// 	})
-// 	stats = append(stats, &neo.Stat{
-// 		EntityID:   uint64(killmail.SolarSystemID),
-// 		EntityType: neo.StatEntitySystem,
-// 		Category:   neo.StatCategoryISKKilled,
-// 		Frequency:  neo.StatFrequencyDaily,
-// 		Date:       date,
-// 		Value:      killmail.TotalValue,
-// 	})
-// 	if killmail.System != nil {
-// 		stats = append(stats, &neo.Stat{
-// 			EntityID:   uint64(killmail.System.ConstellationID),
-// 			EntityType: neo.StatEntityConstellation,
-// 			Category:   neo.StatCategoryShipsKilled,
-// 			Frequency:  neo.StatFrequencyDaily,
-// 			Date:       date,
-// 			Value:      1,
-// 		})
-// 		stats = append(stats, &neo.Stat{
-// 			EntityID:   uint64(killmail.System.ConstellationID),
-// 			EntityType: neo.StatEntityConstellation,
-// 			Category:   neo.StatCategoryISKKilled,
-// 			Frequency:  neo.StatFrequencyDaily,
-// 			Date:       date,
-// 			Value:      killmail.TotalValue,
-// 		})
-// 		if killmail.System.Constellation != nil {
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(killmail.System.Constellation.RegionID),
-// 				EntityType: neo.StatEntityRegion,
-// 				Category:   neo.StatCategoryShipsKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      1,
-// 			})
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(killmail.System.Constellation.RegionID),
-// 				EntityType: neo.StatEntityRegion,
-// 				Category:   neo.StatCategoryISKKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      killmail.TotalValue,
-// 			})
-// 		}
-// 	}
-// 	return stats
-
-// }
-
-// func (s *service) victim(killmail *neo.Killmail) []*neo.Stat {
-// 	date := s.date(killmail.KillmailTime)
-// 	stats := make([]*neo.Stat, 0)
-// 	victim := killmail.Victim
-
-// 	if victim.CharacterID.Valid {
-// 		stats = append(stats, &neo.Stat{
-// 			EntityID:   victim.CharacterID.Uint64,
-// 			EntityType: neo.StatEntityCharacter,
-// 			Category:   neo.StatCategoryShipsLost,
-// 			Frequency:  neo.StatFrequencyDaily,
-// 			Date:       date,
-// 			Value:      1,
-// 		})
-// 		stats = append(stats, &neo.Stat{
-// 			EntityID:   victim.CharacterID.Uint64,
-// 			EntityType: neo.StatEntityCharacter,
-// 			Category:   neo.StatCategoryISKLost,
-// 			Frequency:  neo.StatFrequencyDaily,
-// 			Date:       date,
-// 			Value:      killmail.TotalValue,
-// 		})
-// 	}
-// 	if victim.CorporationID.Valid {
-// 		stats = append(stats, &neo.Stat{
-// 			EntityID:   uint64(victim.CorporationID.Uint),
-// 			EntityType: neo.StatEntityCorporation,
-// 			Category:   neo.StatCategoryShipsLost,
-// 			Frequency:  neo.StatFrequencyDaily,
-// 			Date:       date,
-// 			Value:      1,
-// 		})
-// 		stats = append(stats, &neo.Stat{
-// 			EntityID:   uint64(victim.CorporationID.Uint),
-// 			EntityType: neo.StatEntityCorporation,
-// 			Category:   neo.StatCategoryISKLost,
-// 			Frequency:  neo.StatFrequencyDaily,
-// 			Date:       date,
-// 			Value:      killmail.TotalValue,
-// 		})
-// 	}
-// 	if victim.AllianceID.Valid {
-// 		stats = append(stats, &neo.Stat{
-// 			EntityID:   uint64(victim.AllianceID.Uint),
-// 			EntityType: neo.StatEntityAlliance,
-// 			Category:   neo.StatCategoryShipsLost,
-// 			Frequency:  neo.StatFrequencyDaily,
-// 			Date:       date,
-// 			Value:      1,
-// 		})
-// 		stats = append(stats, &neo.Stat{
-// 			EntityID:   uint64(victim.AllianceID.Uint),
-// 			EntityType: neo.StatEntityAlliance,
-// 			Category:   neo.StatCategoryISKLost,
-// 			Frequency:  neo.StatFrequencyDaily,
-// 			Date:       date,
-// 			Value:      killmail.TotalValue,
-// 		})
-// 	}
-
-// 	stats = append(stats, &neo.Stat{
-// 		EntityID:   uint64(victim.ShipTypeID),
-// 		EntityType: neo.StatEntityShip,
-// 		Category:   neo.StatCategoryShipsLost,
-// 		Frequency:  neo.StatFrequencyDaily,
-// 		Date:       date,
-// 		Value:      1,
-// 	})
-// 	stats = append(stats, &neo.Stat{
-// 		EntityID:   uint64(victim.ShipTypeID),
-// 		EntityType: neo.StatEntityShip,
-// 		Category:   neo.StatCategoryISKLost,
-// 		Frequency:  neo.StatFrequencyDaily,
-// 		Date:       date,
-// 		Value:      killmail.TotalValue,
-// 	})
-
-// 	stats = append(stats, &neo.Stat{
-// 		EntityID:   uint64(victim.ShipGroupID),
-// 		EntityType: neo.StatEntityShipGroup,
-// 		Category:   neo.StatCategoryShipsLost,
-// 		Frequency:  neo.StatFrequencyDaily,
-// 		Date:       date,
-// 		Value:      1,
-// 	})
-// 	stats = append(stats, &neo.Stat{
-// 		EntityID:   uint64(victim.ShipGroupID),
-// 		EntityType: neo.StatEntityShipGroup,
-// 		Category:   neo.StatCategoryISKLost,
-// 		Frequency:  neo.StatFrequencyDaily,
-// 		Date:       date,
-// 		Value:      killmail.TotalValue,
-// 	})
-
-// 	return stats
+	killmail, err := s.killmails.Killmail(ctx, msg.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			entry.Warn("killmail does not exist, skipping stats")
+			return nil
+		}
+		txn.NoticeError(err)
+		return errors.Wrap(err, "failed to fetch killmail for stats")
+	}
 
-// }
+	stats := counters(killmail, "", neo.AllStatFrequencys...)
+	if len(stats) == 0 {
+		return nil
+	}
 
-// func (s *service) attackers(killmail *neo.Killmail) []*neo.Stat {
-// 	date := s.date(killmail.KillmailTime)
-// 	stats := make([]*neo.Stat, 0)
-// 	attackers := killmail.Attackers
+	// Messages are redelivered when an ack is lost, so mark the killmail as counted before incrementing
+	// to keep a redelivered message from adding it to the counters twice
+	key := fmt.Sprintf(neo.REDIS_STATS_COUNTED, killmail.ID)
+	marked, err := s.redis.SetNX(ctx, key, time.Now().Unix(), countedTTL).Result()
+	if err != nil {
+		txn.NoticeError(err)
+		return errors.Wrap(err, "failed to mark killmail as counted")
+	}
 
-// 	for _, attacker := range attackers {
-// 		if attacker.CharacterID.Valid {
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   attacker.CharacterID.Uint64,
-// 				EntityType: neo.StatEntityCharacter,
-// 				Category:   neo.StatCategoryShipsKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      1,
-// 			})
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   attacker.CharacterID.Uint64,
-// 				EntityType: neo.StatEntityCharacter,
-// 				Category:   neo.StatCategoryISKKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      killmail.TotalValue,
-// 			})
-// 		}
-// 		if attacker.CorporationID.Valid {
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(attacker.CorporationID.Uint),
-// 				EntityType: neo.StatEntityCorporation,
-// 				Category:   neo.StatCategoryShipsKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      1,
-// 			})
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(attacker.CorporationID.Uint),
-// 				EntityType: neo.StatEntityCorporation,
-// 				Category:   neo.StatCategoryISKKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      killmail.TotalValue,
-// 			})
-// 		}
-// 		if attacker.AllianceID.Valid {
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(attacker.AllianceID.Uint),
-// 				EntityType: neo.StatEntityAlliance,
-// 				Category:   neo.StatCategoryShipsKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      1,
-// 			})
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(attacker.AllianceID.Uint),
-// 				EntityType: neo.StatEntityAlliance,
-// 				Category:   neo.StatCategoryISKKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      killmail.TotalValue,
-// 			})
-// 		}
+	if !marked {
+		entry.Info("killmail has already been counted, skipping stats")
+		return nil
+	}
 
-// 		if attacker.ShipTypeID.Valid {
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(attacker.ShipTypeID.Uint),
-// 				EntityType: neo.StatEntityShip,
-// 				Category:   neo.StatCategoryShipsKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      1,
-// 			})
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(attacker.ShipTypeID.Uint),
-// 				EntityType: neo.StatEntityShip,
-// 				Category:   neo.StatCategoryISKKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      killmail.TotalValue,
-// 			})
+	err = s.IncrementStats(ctx, stats)
+	if err != nil {
+		// The increments are an unordered bulk write, so some of them may have landed before the error. The mark is
+		// kept so a redelivery cannot add those a second time, and the counters are left to a rebuild instead
+		txn.NoticeError(err)
+		entry.WithError(err).WithField("killmail_time", killmail.KillmailTime.Format("20060102")).
+			Error("failed to increment stats, the counters of this killmail must be repaired with stats rebuild")
+		return nil
+	}
 
-// 		}
+	entry.Info("stats calculated successfully")
 
-// 		if attacker.ShipGroupID.Valid {
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(attacker.ShipGroupID.Uint),
-// 				EntityType: neo.StatEntityShipGroup,
-// 				Category:   neo.StatCategoryShipsKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      1,
-// 			})
-// 			stats = append(stats, &neo.Stat{
-// 				EntityID:   uint64(attacker.ShipGroupID.Uint),
-// 				EntityType: neo.StatEntityShipGroup,
-// 				Category:   neo.StatCategoryISKKilled,
-// 				Frequency:  neo.StatFrequencyDaily,
-// 				Date:       date,
-// 				Value:      killmail.TotalValue,
-// 			})
-// 		}
-// 	}
+	return nil
 
-// 	return stats
-// }
+}
@@ -0,0 +1,122 @@
+package stats
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+)
+
+// EntityStats summarizes the performance of an entity over the period. Periods that line up with a stat frequency
+// are read from the counters, rolling periods are aggregated from the killmails
+func (s *service) EntityStats(ctx context.Context, entity neo.StatEntity, id uint64, period neo.StatPeriod) (*neo.EntityStats, error) {
+
+	if !period.IsValid() {
+		return nil, errors.Errorf("%s is not a valid stat period", period)
+	}
+
+	var (
+		kills, losses *neo.KillmailTotals
+		err           error
+	)
+
+	if frequency, ok := period.Frequency(); ok {
+		kills, losses, err = s.countedTotals(ctx, entity, id, frequency)
+	} else {
+		kills, losses, err = s.aggregatedTotals(ctx, entity, id, period.Since(time.Now()))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &neo.EntityStats{
+		Period:       period,
+		Kills:        kills.Count,
+		Losses:       losses.Count,
+		ISKDestroyed: kills.TotalValue,
+		ISKLost:      losses.TotalValue,
+		SoloKills:    kills.Solo,
+	}
+
+	if stats.ISKDestroyed+stats.ISKLost > 0 {
+		stats.ISKEfficiency = stats.ISKDestroyed / (stats.ISKDestroyed + stats.ISKLost)
+	}
+
+	if stats.Kills+stats.Losses > 0 {
+		stats.DangerRatio = float64(stats.Kills) / float64(stats.Kills+stats.Losses)
+	}
+
+	if stats.Kills > 0 {
+		stats.AverageGangSize = float64(kills.Attackers) / float64(stats.Kills)
+	}
+
+	return stats, nil
+
+}
+
+func (s *service) countedTotals(ctx context.Context, entity neo.StatEntity, id uint64, frequency neo.StatFrequency) (*neo.KillmailTotals, *neo.KillmailTotals, error) {
+
+	stats, err := s.AllStats(
+		ctx,
+		neo.NewEqualOperator("entityType", entity),
+		neo.NewEqualOperator("entityID", id),
+		neo.NewEqualOperator("frequency", frequency),
+		neo.NewEqualOperator("date", frequency.Truncate(time.Now())),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to fetch stats")
+	}
+
+	kills, losses := new(neo.KillmailTotals), new(neo.KillmailTotals)
+	for _, stat := range stats {
+		switch stat.Category {
+		case neo.StatCategoryShipsKilled:
+			kills.Count = uint(stat.Value)
+		case neo.StatCategoryISKKilled:
+			kills.TotalValue = stat.Value
+		case neo.StatCategorySoloKills:
+			kills.Solo = uint(stat.Value)
+		case neo.StatCategoryAttackers:
+			kills.Attackers = uint(stat.Value)
+		case neo.StatCategoryShipsLost:
+			losses.Count = uint(stat.Value)
+		case neo.StatCategoryISKLost:
+			losses.TotalValue = stat.Value
+		}
+	}
+
+	return kills, losses, nil
+
+}
+
+func (s *service) aggregatedTotals(ctx context.Context, entity neo.StatEntity, id uint64, since time.Time) (*neo.KillmailTotals, *neo.KillmailTotals, error) {
+
+	column, ok := entity.InvolvementColumn()
+	if !ok {
+		return nil, nil, errors.Errorf("stats for rolling periods are not available for %s", entity)
+	}
+
+	kills, err := s.killmails.KillmailTotals(
+		ctx,
+		neo.NewEqualOperator("attackers."+column, id),
+		neo.NewEqualOperator("isNPC", false),
+		neo.NewGreaterThanEqualToOperator("killmailTime", since),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to aggregate kills")
+	}
+
+	losses, err := s.killmails.KillmailTotals(
+		ctx,
+		neo.NewEqualOperator("victim."+column, id),
+		neo.NewEqualOperator("isNPC", false),
+		neo.NewGreaterThanEqualToOperator("killmailTime", since),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to aggregate losses")
+	}
+
+	return kills, losses, nil
+
+}
@@ -0,0 +1,76 @@
+package token
+
+import (
+	"context"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+)
+
+// ErrAccountHasAlts is returned when linking a character that is the main of an account with alts to another account
+var ErrAccountHasAlts = errors.New("character is the main of an account with alts and cannot be linked to another account")
+
+// Account returns the tokens of every character on the account that the character belongs to, main first
+func (s *service) Account(ctx context.Context, characterID uint64) ([]*neo.Token, error) {
+
+	main, err := s.AccountMain(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.AccountTokens(ctx, main)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch account tokens")
+	}
+
+	for i, token := range tokens {
+		if token.ID == main {
+			tokens[0], tokens[i] = tokens[i], tokens[0]
+			break
+		}
+	}
+
+	return tokens, nil
+
+}
+
+// AccountMain resolves the main character of the account a character belongs to
+func (s *service) AccountMain(ctx context.Context, characterID uint64) (uint64, error) {
+
+	token, err := s.Token(ctx, characterID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch token")
+	}
+
+	if token.Main == 0 {
+		return token.ID, nil
+	}
+
+	return token.Main, nil
+
+}
+
+func (s *service) linkToAccount(ctx context.Context, token *neo.Token, main uint64) error {
+
+	if token.ID == main {
+		return nil
+	}
+
+	if token.Main == 0 || token.Main == token.ID {
+		tokens, err := s.AccountTokens(ctx, token.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to fetch account tokens")
+		}
+
+		for _, t := range tokens {
+			if t.ID != token.ID {
+				return ErrAccountHasAlts
+			}
+		}
+	}
+
+	token.Main = main
+
+	return nil
+
+}
@@ -0,0 +1,307 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// Access tokens are refreshed when they are within this long of expiring
+	refreshLeeway = time.Minute * 5
+	// How often the manager looks for tokens that are about to expire
+	refreshInterval = time.Minute
+	// The refresh lock outlives a refresh round trip to SSO. Processes that find the lock taken poll for it for
+	// up to refreshLockWait
+	refreshLockTTL  = time.Second * 30
+	refreshLockWait = time.Second * 30
+	refreshLockPoll = time.Millisecond * 250
+
+	errInvalidGrant = "invalid_grant"
+)
+
+// ErrTokenDisabled is returned when a token source is requested for a token that has been disabled
+var ErrTokenDisabled = errors.New("token has been disabled")
+
+// releaseRefreshLock deletes the refresh lock only if it is still held by the caller, so that a lock that expired
+// and was taken by another process is not released from under it
+var releaseRefreshLock = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0
+`)
+
+// Run refreshes tokens that are about to expire on an interval until the context is cancelled
+func (s *service) Run(ctx context.Context) {
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		refreshed, err := s.RefreshExpiring(ctx)
+		if err != nil {
+			s.logger.WithContext(ctx).WithError(err).Error("failed to refresh expiring tokens")
+		}
+
+		if refreshed > 0 {
+			s.logger.WithContext(ctx).WithField("refreshed", refreshed).Info("refreshed expiring tokens")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+
+}
+
+// RefreshExpiring refreshes every enabled token that expires within the refresh leeway and returns the number of
+// tokens that were refreshed. Tokens that SSO refuses to refresh are disabled
+func (s *service) RefreshExpiring(ctx context.Context) (int, error) {
+
+	tokens, err := s.Tokens(
+		ctx,
+		neo.NewEqualOperator("disabled", false),
+		neo.NewLessThanOperator("expiry", time.Now().Add(refreshLeeway)),
+	)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch expiring tokens")
+	}
+
+	refreshed := 0
+	for _, token := range tokens {
+		_, err := s.sourceFor(ctx, token).Token()
+		if err != nil {
+			s.logger.WithContext(ctx).WithError(err).WithField("character_id", token.ID).Error("failed to refresh token")
+			continue
+		}
+
+		refreshed++
+	}
+
+	return refreshed, nil
+
+}
+
+// TokenSource returns a token source for the character's stored token. Access tokens handed out by the source are
+// refreshed shortly before they expire and the rotated pair is persisted, so callers should ask the source for a
+// token before every authenticated request rather than holding on to one
+func (s *service) TokenSource(ctx context.Context, characterID uint64) (oauth2.TokenSource, error) {
+
+	token, err := s.Token(ctx, characterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch token")
+	}
+
+	if token.Disabled {
+		return nil, ErrTokenDisabled
+	}
+
+	return s.sourceFor(ctx, token), nil
+
+}
+
+func (s *service) sourceFor(ctx context.Context, token *neo.Token) oauth2.TokenSource {
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, s.client)
+
+	return &persistingTokenSource{
+		ctx:     ctx,
+		service: s,
+		token:   token,
+	}
+
+}
+
+// persistingTokenSource refreshes a stored token through SSO and writes the rotated access and refresh token back
+// to the repository. A token that SSO rejects with invalid_grant is disabled. SSO rotates the refresh token on every
+// refresh, so refreshes are serialised across processes by a lock in Redis
+type persistingTokenSource struct {
+	ctx     context.Context
+	service *service
+	mx      sync.Mutex
+	token   *neo.Token
+}
+
+func (p *persistingTokenSource) Token() (*oauth2.Token, error) {
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.token.Disabled {
+		return nil, ErrTokenDisabled
+	}
+
+	if current, fresh := p.current(); fresh {
+		return current, nil
+	}
+
+	release, err := p.service.lockRefresh(p.ctx, p.token.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Another process may have refreshed the token while we waited on the lock, in which case the refresh token
+	// we hold has already been spent
+	stored, err := p.service.Token(p.ctx, p.token.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reload token")
+	}
+	p.token = stored
+
+	if p.token.Disabled {
+		return nil, ErrTokenDisabled
+	}
+
+	current, fresh := p.current()
+	if fresh {
+		return current, nil
+	}
+
+	presented := current.RefreshToken
+	current.Expiry = time.Time{}
+	current.AccessToken = ""
+
+	refreshed, err := p.service.oauth.TokenSource(p.ctx, current).Token()
+	if err != nil {
+		if !isInvalidGrant(err) {
+			return nil, errors.Wrap(err, "failed to refresh token")
+		}
+
+		// A refresh token that was rotated by someone else in the meantime is rejected too, but the token
+		// itself is fine
+		stored, serr := p.service.Token(p.ctx, p.token.ID)
+		if serr != nil {
+			return nil, errors.Wrap(serr, "failed to reload token")
+		}
+
+		if stored.RefreshToken != presented {
+			p.token = stored
+			return nil, errors.New("refresh token was rotated by another process during refresh")
+		}
+
+		return nil, p.disable(errInvalidGrant)
+	}
+
+	p.token.AccessToken = refreshed.AccessToken
+	p.token.Expiry = refreshed.Expiry
+	if refreshed.RefreshToken != "" {
+		p.token.RefreshToken = refreshed.RefreshToken
+	}
+
+	_, err = p.service.UpdateToken(p.ctx, p.token.ID, p.token)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to persist refreshed token")
+	}
+
+	return refreshed, nil
+
+}
+
+// current returns the stored token and whether its access token is fresh enough to hand out. Access tokens are
+// expired early so that one is never handed out moments before it stops working
+func (p *persistingTokenSource) current() (*oauth2.Token, bool) {
+
+	current := &oauth2.Token{
+		AccessToken:  p.token.AccessToken,
+		RefreshToken: p.token.RefreshToken,
+		TokenType:    "Bearer",
+		Expiry:       p.token.Expiry,
+	}
+
+	return current, time.Until(current.Expiry) > refreshLeeway
+
+}
+
+func (p *persistingTokenSource) disable(reason string) error {
+
+	p.token.Disabled = true
+	p.token.DisabledReason = reason
+	p.token.DisabledTimestamp = null.TimeFrom(time.Now())
+
+	_, err := p.service.UpdateToken(p.ctx, p.token.ID, p.token)
+	if err != nil {
+		return errors.Wrap(err, "failed to disable token")
+	}
+
+	p.service.logger.WithField("character_id", p.token.ID).WithField("reason", reason).Warn("token disabled")
+
+	return ErrTokenDisabled
+
+}
+
+// lockRefresh takes the refresh lock of the token, waiting up to refreshLockWait for another process to release
+// it. The returned func releases the lock
+func (s *service) lockRefresh(ctx context.Context, id uint64) (func(), error) {
+
+	key := fmt.Sprintf(neo.REDIS_TOKEN_REFRESH_LOCK, id)
+
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate refresh lock value")
+	}
+	value := hex.EncodeToString(b)
+
+	deadline := time.Now().Add(refreshLockWait)
+	for {
+		acquired, err := s.redis.SetNX(ctx, key, value, refreshLockTTL).Result()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to acquire token refresh lock")
+		}
+
+		if acquired {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			return nil, errors.Errorf("timed out waiting on the refresh lock of token %d", id)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(refreshLockPoll):
+		}
+	}
+
+	return func() {
+		err := releaseRefreshLock.Run(ctx, s.redis, []string{key}, value).Err()
+		if err != nil {
+			s.logger.WithContext(ctx).WithError(err).WithField("character_id", id).Error("failed to release token refresh lock")
+		}
+	}, nil
+
+}
+
+func isInvalidGrant(err error) bool {
+
+	var retrieveErr *oauth2.RetrieveError
+	if !errors.As(err, &retrieveErr) {
+		return false
+	}
+
+	var body struct {
+		Error string `json:"error"`
+	}
+
+	if json.Unmarshal(retrieveErr.Body, &body) != nil {
+		return false
+	}
+
+	return body.Error == errInvalidGrant
+
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/eveisesi/neo"
 	"github.com/go-redis/redis/v8"
@@ -15,7 +16,17 @@ import (
 
 type Service interface {
 	GetState(state string, scopes []string) string
-	GetTokenForCode(ctx context.Context, state, code string) (*neo.Token, error)
+	GetTokenForCode(ctx context.Context, state, code string, main uint64) (*neo.Token, error)
+	Account(ctx context.Context, characterID uint64) ([]*neo.Token, error)
+	AccountMain(ctx context.Context, characterID uint64) (uint64, error)
+	TokenSource(ctx context.Context, characterID uint64) (oauth2.TokenSource, error)
+	RefreshExpiring(ctx context.Context) (int, error)
+	Run(ctx context.Context)
+
+	IssueSession(ctx context.Context, characterID uint64) (string, error)
+	Session(ctx context.Context, raw string) (*neo.Viewer, error)
+	RevokeSession(ctx context.Context, sessionID string) error
+
 	neo.TokenRepository
 }
 
@@ -25,6 +36,10 @@ type service struct {
 	logger  *logrus.Logger
 	redis   *redis.Client
 	jwksURL string
+
+	sessionSecret []byte
+	sessionTTL    time.Duration
+
 	neo.TokenRepository
 }
 
@@ -51,6 +66,8 @@ func NewService(
 	logger *logrus.Logger,
 	redis *redis.Client,
 	jwksURL string,
+	sessionSecret string,
+	sessionTTL time.Duration,
 	token neo.TokenRepository,
 ) Service {
 	return &service{
@@ -60,5 +77,7 @@ func NewService(
 		logger:          logger,
 		redis:           redis,
 		jwksURL:         jwksURL,
+		sessionSecret:   []byte(sessionSecret),
+		sessionTTL:      sessionTTL,
 	}
 }
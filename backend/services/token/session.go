@@ -0,0 +1,92 @@
+package token
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+)
+
+const sessionIssuer = "neo"
+
+// ErrInvalidSession is returned for session tokens that are malformed, expired, signed with another key or revoked
+var ErrInvalidSession = errors.New("invalid session")
+
+// IssueSession creates a session for the character and returns a signed session token for it. Sessions are tracked
+// in redis so that they can be revoked before they expire
+func (s *service) IssueSession(ctx context.Context, characterID uint64) (string, error) {
+
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate session id")
+	}
+
+	now := time.Now()
+	claims := jwt.StandardClaims{
+		Id:        hex.EncodeToString(id),
+		Issuer:    sessionIssuer,
+		Subject:   strconv.FormatUint(characterID, 10),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.sessionTTL).Unix(),
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.sessionSecret)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign session token")
+	}
+
+	err = s.redis.Set(ctx, fmt.Sprintf(neo.REDIS_SESSION, claims.Id), characterID, s.sessionTTL).Err()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to store session")
+	}
+
+	return signed, nil
+
+}
+
+// Session validates a session token and resolves it into the viewer it was issued to
+func (s *service) Session(ctx context.Context, raw string) (*neo.Viewer, error) {
+
+	claims := new(jwt.StandardClaims)
+	_, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, errors.Errorf("unexpected signing method %s", token.Header["alg"])
+		}
+		return s.sessionSecret, nil
+	})
+	if err != nil || claims.Issuer != sessionIssuer {
+		return nil, ErrInvalidSession
+	}
+
+	characterID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+
+	count, err := s.redis.Exists(ctx, fmt.Sprintf(neo.REDIS_SESSION, claims.Id)).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to look up session")
+	}
+
+	if count == 0 {
+		return nil, ErrInvalidSession
+	}
+
+	return &neo.Viewer{
+		CharacterID: characterID,
+		SessionID:   claims.Id,
+	}, nil
+
+}
+
+// RevokeSession ends a session before it expires
+func (s *service) RevokeSession(ctx context.Context, sessionID string) error {
+	return s.redis.Del(ctx, fmt.Sprintf(neo.REDIS_SESSION, sessionID)).Err()
+}
@@ -16,13 +16,17 @@ import (
 	"github.com/eveisesi/neo"
 	"github.com/lestrrat-go/jwx/jwk"
 	"github.com/pkg/errors"
+	"github.com/volatiletech/null"
 )
 
 func (s *service) GetState(state string, scopes []string) string {
 	return s.oauth.AuthCodeURL(state, oauth2.SetAuthURLParam("scope", strings.Join(scopes, " ")))
 }
 
-func (s *service) GetTokenForCode(ctx context.Context, state, code string) (*neo.Token, error) {
+// GetTokenForCode exchanges an SSO code for a token and stores it. When main is not zero the character is linked
+// as an alt to the account of main, otherwise the character becomes the main of its own account unless it is
+// already linked to one
+func (s *service) GetTokenForCode(ctx context.Context, state, code string, main uint64) (*neo.Token, error) {
 
 	// Exchange code for token from Oauth2.0 Service
 	token, err := s.oauth.Exchange(ctx, code)
@@ -44,6 +48,15 @@ func (s *service) GetTokenForCode(ctx context.Context, state, code string) (*neo
 		return nil, errors.Wrap(err, "unable to coerce string to int")
 	}
 
+	scopes := scopesFromClaims(parsed.Claims.(jwt.MapClaims))
+
+	if main > 0 {
+		main, err = s.AccountMain(ctx, main)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// // Check to see if we know who this character is
 	neoToken, err := s.Token(ctx, characterID)
 	if err != nil && err != mongo.ErrNoDocuments {
@@ -56,28 +69,70 @@ func (s *service) GetTokenForCode(ctx context.Context, state, code string) (*neo
 			ID:           characterID,
 			AccessToken:  token.AccessToken,
 			RefreshToken: token.RefreshToken,
-			Expiry:       time.Now().Add(time.Minute * 19),
+			Expiry:       token.Expiry,
+			Scopes:       scopes,
+			Main:         characterID,
+		}
+
+		if main > 0 {
+			neoToken.Main = main
 		}
 
 		neoToken, err = s.CreateToken(ctx, neoToken)
 		if err != nil {
 			return nil, errors.Wrap(err, "unable to create token")
 		}
+
+		return neoToken, nil
 	}
-	if err == nil && neoToken != nil {
-		neoToken = &neo.Token{
-			AccessToken:  token.AccessToken,
-			RefreshToken: token.RefreshToken,
-			Expiry:       time.Now().Add(time.Minute * 19),
-		}
 
-		neoToken, err = s.UpdateToken(ctx, characterID, neoToken)
+	neoToken.AccessToken = token.AccessToken
+	neoToken.RefreshToken = token.RefreshToken
+	neoToken.Expiry = token.Expiry
+	neoToken.Scopes = scopes
+	// Signing in again with a token that was disabled grants us a fresh refresh token
+	neoToken.Disabled = false
+	neoToken.DisabledReason = ""
+	neoToken.DisabledTimestamp = null.Time{}
+
+	if main > 0 && main != neoToken.Main {
+		err = s.linkToAccount(ctx, neoToken, main)
 		if err != nil {
-			return nil, errors.Wrap(err, "unable to update token")
+			return nil, err
 		}
 	}
 
-	return neoToken, errors.Wrap(err, "unable to generate jwt token. key is not set and/or missing")
+	// Tokens stored before accounts existed are the main of their own account
+	if neoToken.Main == 0 {
+		neoToken.Main = characterID
+	}
+
+	neoToken, err = s.UpdateToken(ctx, characterID, neoToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to update token")
+	}
+
+	return neoToken, nil
+}
+
+// scopesFromClaims returns the scopes granted to an access token. SSO encodes a single scope as a string
+// and multiple scopes as an array
+func scopesFromClaims(claims jwt.MapClaims) []string {
+
+	scopes := make([]string, 0)
+	switch scp := claims["scp"].(type) {
+	case string:
+		scopes = append(scopes, scp)
+	case []interface{}:
+		for _, scope := range scp {
+			if str, ok := scope.(string); ok {
+				scopes = append(scopes, str)
+			}
+		}
+	}
+
+	return scopes
+
 }
 
 func (s *service) getSignatureKey(token *jwt.Token) (interface{}, error) {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/services/queue"
 	"github.com/go-redis/redis/v8"
 	"github.com/inancgumus/screen"
 	"github.com/jedib0t/go-pretty/table"
@@ -21,6 +22,7 @@ type Service interface {
 type (
 	service struct {
 		redis *redis.Client
+		queue queue.Service
 	}
 
 	stat struct {
@@ -45,8 +47,14 @@ type (
 		ESIErrorRemain     int64
 		PrevESIErrorRemain int64
 
-		ProcessingQueue     int64
-		PrevProcessingQueue int64
+		LiveQueue     int64
+		PrevLiveQueue int64
+
+		ManualQueue     int64
+		PrevManualQueue int64
+
+		BackfillQueue     int64
+		PrevBackfillQueue int64
 
 		RecalculatingQueue     int64
 		PrevRecalculatingQueue int64
@@ -63,15 +71,19 @@ type (
 		InvalidQueue     int64
 		PrevInvalidQueue int64
 
+		DeadLetterQueue     int64
+		PrevDeadLetterQueue int64
+
 		WebsocketConnections     int64
 		PrevWebsocketConnections int64
 	}
 )
 
-func NewService(redis *redis.Client) Service {
+func NewService(redis *redis.Client, queue queue.Service) Service {
 
 	s := &service{
 		redis: redis,
+		queue: queue,
 	}
 
 	return s
@@ -105,8 +117,8 @@ func (s *service) fetchESIErrorRemain(ctx context.Context) (int64, error) {
 	return s.redis.Get(ctx, neo.REDIS_ESI_ERROR_COUNT).Int64()
 }
 
-func (s *service) fetchProcessingQueue(ctx context.Context) (int64, error) {
-	return s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_PROCESSING, "-inf", "+inf").Result()
+func (s *service) fetchProcessingQueue(ctx context.Context, lane neo.Lane) (int64, error) {
+	return s.queue.Len(ctx, lane.Queue())
 }
 
 func (s *service) fetchRecalculatingQueue(ctx context.Context) (int64, error) {
@@ -118,17 +130,21 @@ func (s *service) fetchBackupQueue(ctx context.Context) (int64, error) {
 }
 
 func (s *service) fetchStatsQueue(ctx context.Context) (int64, error) {
-	return s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_STATS, "-inf", "+inf").Result()
+	return s.queue.Len(ctx, neo.QUEUES_KILLMAIL_STATS)
 }
 
 func (s *service) fetchNotificationQueue(ctx context.Context) (int64, error) {
-	return s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION, "-inf", "+inf").Result()
+	return s.queue.Len(ctx, neo.QUEUES_KILLMAIL_NOTIFICATION)
 }
 
 func (s *service) fetchInvalidQueue(ctx context.Context) (int64, error) {
 	return s.redis.ZCount(ctx, neo.ZKB_INVALID_HASH, "-inf", "+inf").Result()
 }
 
+func (s *service) fetchDeadLetterQueue(ctx context.Context) (int64, error) {
+	return s.redis.ZCount(ctx, neo.QUEUES_KILLMAIL_DLQ, "-inf", "+inf").Result()
+}
+
 func (s *service) fetchWebsocketConnections(ctx context.Context) (int64, error) {
 	count, _ := s.redis.Get(ctx, neo.WEBSOCKET_CONNECTIONS).Int64()
 	return count, nil
@@ -173,7 +189,17 @@ func (s *service) EvaluateParams(param *stat) error {
 		return errors.Wrap(err, "fetchESIErrorReset failed")
 	}
 
-	param.ProcessingQueue, err = s.fetchProcessingQueue(ctx)
+	param.LiveQueue, err = s.fetchProcessingQueue(ctx, neo.LaneLive)
+	if err != nil {
+		return errors.Wrap(err, "fetchProcessingQueue failed")
+	}
+
+	param.ManualQueue, err = s.fetchProcessingQueue(ctx, neo.LaneManual)
+	if err != nil {
+		return errors.Wrap(err, "fetchProcessingQueue failed")
+	}
+
+	param.BackfillQueue, err = s.fetchProcessingQueue(ctx, neo.LaneBackfill)
 	if err != nil {
 		return errors.Wrap(err, "fetchProcessingQueue failed")
 	}
@@ -203,6 +229,11 @@ func (s *service) EvaluateParams(param *stat) error {
 		return errors.Wrap(err, "fetchInvalidQueue failed")
 	}
 
+	param.DeadLetterQueue, err = s.fetchDeadLetterQueue(ctx)
+	if err != nil {
+		return errors.Wrap(err, "fetchDeadLetterQueue failed")
+	}
+
 	param.WebsocketConnections, err = s.fetchWebsocketConnections(ctx)
 	if err != nil {
 		return errors.Wrap(err, "fetchWebsocketConnections failed")
@@ -218,12 +249,15 @@ func (s *service) SetPrevParams(params *stat) {
 	params.PrevESI420 = params.ESI420
 	params.PrevESI4XX = params.ESI4XX
 	params.PrevESI5XX = params.ESI5XX
-	params.PrevProcessingQueue = params.ProcessingQueue
+	params.PrevLiveQueue = params.LiveQueue
+	params.PrevManualQueue = params.ManualQueue
+	params.PrevBackfillQueue = params.BackfillQueue
 	params.PrevRecalculatingQueue = params.RecalculatingQueue
 	params.PrevBackupQueue = params.BackupQueue
 	params.PrevStatsQueue = params.StatsQueue
 	params.PrevNotificationsQueue = params.NotificationsQueue
 	params.PrevInvalidQueue = params.InvalidQueue
+	params.PrevDeadLetterQueue = params.DeadLetterQueue
 	params.PrevWebsocketConnections = params.WebsocketConnections
 }
 
@@ -243,9 +277,19 @@ func (s *service) Run() error {
 		columns := [][]string{
 			[]string{
 				fmt.Sprintf(
-					"%d: Queue Processing (%d)",
-					params.ProcessingQueue,
-					params.ProcessingQueue-params.PrevProcessingQueue,
+					"%d: Queue Processing Live (%d)",
+					params.LiveQueue,
+					params.LiveQueue-params.PrevLiveQueue,
+				),
+				fmt.Sprintf(
+					"%d: Queue Processing Manual (%d)",
+					params.ManualQueue,
+					params.ManualQueue-params.PrevManualQueue,
+				),
+				fmt.Sprintf(
+					"%d: Queue Processing Backfill (%d)",
+					params.BackfillQueue,
+					params.BackfillQueue-params.PrevBackfillQueue,
 				),
 				fmt.Sprintf(
 					"%d: Queue Recalculating (%d)",
@@ -272,6 +316,11 @@ func (s *service) Run() error {
 					params.InvalidQueue,
 					params.InvalidQueue-params.PrevInvalidQueue,
 				),
+				fmt.Sprintf(
+					"%d: Queue Dead Letter (%d)",
+					params.DeadLetterQueue,
+					params.DeadLetterQueue-params.PrevDeadLetterQueue,
+				),
 				"",
 				fmt.Sprintf(
 					"%d: Websocket Connections (%d)",
@@ -0,0 +1,33 @@
+package watchlist
+
+import (
+	"context"
+
+	"github.com/eveisesi/neo"
+	"github.com/eveisesi/neo/services/killmail"
+	"github.com/eveisesi/neo/services/token"
+	"github.com/sirupsen/logrus"
+)
+
+type Service interface {
+	Watchlist(ctx context.Context, characterID uint64) ([]*neo.WatchlistEntry, error)
+	Watch(ctx context.Context, characterID uint64, entity neo.WatchEntity, entityID uint64) (*neo.WatchlistEntry, error)
+	Unwatch(ctx context.Context, characterID uint64, entity neo.WatchEntity, entityID uint64) (bool, error)
+	Killmails(ctx context.Context, characterID uint64, args neo.ConnectionArgs) (*neo.KillmailConnection, error)
+}
+
+type service struct {
+	logger   *logrus.Logger
+	token    token.Service
+	killmail killmail.Service
+	neo.WatchlistRepository
+}
+
+func NewService(logger *logrus.Logger, token token.Service, killmail killmail.Service, watchlist neo.WatchlistRepository) Service {
+	return &service{
+		logger,
+		token,
+		killmail,
+		watchlist,
+	}
+}
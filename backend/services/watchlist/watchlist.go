@@ -0,0 +1,93 @@
+package watchlist
+
+import (
+	"context"
+	"time"
+
+	"github.com/eveisesi/neo"
+	"github.com/pkg/errors"
+)
+
+// maxEntries caps the size of a watchlist, since every entry adds a clause to the watchlist killmails query
+const maxEntries = 100
+
+// Watchlist returns the watchlist of the account the character belongs to
+func (s *service) Watchlist(ctx context.Context, characterID uint64) ([]*neo.WatchlistEntry, error) {
+
+	accountID, err := s.token.AccountMain(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.WatchlistRepository.Watchlist(ctx, accountID)
+
+}
+
+func (s *service) Watch(ctx context.Context, characterID uint64, entity neo.WatchEntity, entityID uint64) (*neo.WatchlistEntry, error) {
+
+	if !entity.IsValid() {
+		return nil, errors.Errorf("%s is not a valid entity", entity)
+	}
+
+	if entityID == 0 {
+		return nil, errors.New("id is required")
+	}
+
+	accountID, err := s.token.AccountMain(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.CountWatchlist(ctx, accountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count watchlist")
+	}
+
+	if count >= maxEntries {
+		return nil, errors.Errorf("watchlists are limited to %d entries", maxEntries)
+	}
+
+	entry := &neo.WatchlistEntry{
+		AccountID: accountID,
+		Entity:    entity,
+		EntityID:  entityID,
+		CreatedAt: time.Now(),
+	}
+
+	err = s.WatchlistRepository.Watch(ctx, entry)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to watch entity")
+	}
+
+	return entry, nil
+
+}
+
+// Unwatch removes the entity from the account's watchlist and reports whether it was being watched
+func (s *service) Unwatch(ctx context.Context, characterID uint64, entity neo.WatchEntity, entityID uint64) (bool, error) {
+
+	accountID, err := s.token.AccountMain(ctx, characterID)
+	if err != nil {
+		return false, err
+	}
+
+	return s.WatchlistRepository.Unwatch(ctx, accountID, entity, entityID)
+
+}
+
+// Killmails returns a page of killmails involving any entity on the account's watchlist
+func (s *service) Killmails(ctx context.Context, characterID uint64, args neo.ConnectionArgs) (*neo.KillmailConnection, error) {
+
+	accountID, err := s.token.AccountMain(ctx, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.WatchlistRepository.Watchlist(ctx, accountID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch watchlist")
+	}
+
+	return s.killmail.KillmailsByWatchlist(ctx, accountID, entries, args)
+
+}
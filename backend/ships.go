@@ -0,0 +1,112 @@
+package neo
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ShipCount is the number of killmails, and the ISK they were worth, that a ship type or group appeared on
+type ShipCount struct {
+	ID         uint         `json:"id"`
+	Grouping   ShipGrouping `json:"grouping"`
+	Killmails  uint         `json:"killmails"`
+	TotalValue float64      `json:"totalValue"`
+}
+
+// ShipUsage selects the ships of an entity's killmails that are counted
+type ShipUsage string
+
+const (
+	// ShipUsageFlown counts the ships the entity's attackers flew on its kills
+	ShipUsageFlown ShipUsage = "flown"
+	// ShipUsageKilled counts the victim ships of the entity's kills
+	ShipUsageKilled ShipUsage = "killed"
+	// ShipUsageLost counts the ships the entity lost
+	ShipUsageLost ShipUsage = "lost"
+)
+
+var AllShipUsages = []ShipUsage{
+	ShipUsageFlown,
+	ShipUsageKilled,
+	ShipUsageLost,
+}
+
+func (e ShipUsage) IsValid() bool {
+	switch e {
+	case ShipUsageFlown, ShipUsageKilled, ShipUsageLost:
+		return true
+	}
+	return false
+}
+
+func (e ShipUsage) String() string {
+	return string(e)
+}
+
+func (e *ShipUsage) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ShipUsage(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ShipUsage", str)
+	}
+	return nil
+}
+
+func (e ShipUsage) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// ShipGrouping selects whether ships are counted by their type or by their group
+type ShipGrouping string
+
+const (
+	ShipGroupingType  ShipGrouping = "type"
+	ShipGroupingGroup ShipGrouping = "group"
+)
+
+var AllShipGroupings = []ShipGrouping{
+	ShipGroupingType,
+	ShipGroupingGroup,
+}
+
+func (e ShipGrouping) IsValid() bool {
+	switch e {
+	case ShipGroupingType, ShipGroupingGroup:
+		return true
+	}
+	return false
+}
+
+func (e ShipGrouping) String() string {
+	return string(e)
+}
+
+// Column returns the column of the ship on the attackers and victim of a killmail
+func (e ShipGrouping) Column() string {
+	if e == ShipGroupingGroup {
+		return "shipGroupID"
+	}
+	return "shipTypeID"
+}
+
+func (e *ShipGrouping) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ShipGrouping(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ShipGrouping", str)
+	}
+	return nil
+}
+
+func (e ShipGrouping) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -9,23 +9,40 @@ import (
 	"time"
 )
 
+// EntityStats summarizes the performance of an entity over a period
+type EntityStats struct {
+	Period          StatPeriod `json:"period"`
+	Kills           uint       `json:"kills"`
+	Losses          uint       `json:"losses"`
+	ISKDestroyed    float64    `json:"iskDestroyed"`
+	ISKLost         float64    `json:"iskLost"`
+	ISKEfficiency   float64    `json:"iskEfficiency"`
+	SoloKills       uint       `json:"soloKills"`
+	DangerRatio     float64    `json:"dangerRatio"`
+	AverageGangSize float64    `json:"averageGangSize"`
+}
+
 type StatsRepository interface {
 	AllStats(ctx context.Context, operators ...*Operator) ([]*Stat, error)
-	CreateStats(ctx context.Context, stats []*Stat) error
+	// IncrementStats adds the value of every stat to its counter, creating the counters that do not exist yet
+	IncrementStats(ctx context.Context, stats []*Stat) error
 	DeleteStats(ctx context.Context, operators ...*Operator) error
+	// RollupAlltimeStats replaces the all-time counters of the entity type with the sum of its yearly counters
+	RollupAlltimeStats(ctx context.Context, entity StatEntity) error
 }
 
-// Stat is an object representing the database table.
+// Stat is a counter of a category for a single entity. A counter is identified by its entity, category, frequency
+// and date, where the date is the start of the day, month or year that the counter covers. All-time counters have
+// a zero date
 type Stat struct {
-	ID         uint64        `db:"id" json:"id"`
-	EntityID   uint64        `db:"entity_id" json:"entityID"`
-	EntityType StatEntity    `db:"entity_type" json:"entityType"`
-	Category   StatCategory  `db:"category" json:"category"`
-	Frequency  StatFrequency `db:"frequency" json:"frequency"`
-	Date       *Date         `db:"date" json:"date"`
-	Value      float64       `db:"value" json:"value"`
-	CreatedAt  time.Time     `db:"created_at" json:"createdAt"`
-	UpdatedAt  time.Time     `db:"updated_at" json:"updatedAt"`
+	EntityID   uint64        `bson:"entityID" json:"entityID"`
+	EntityType StatEntity    `bson:"entityType" json:"entityType"`
+	Category   StatCategory  `bson:"category" json:"category"`
+	Frequency  StatFrequency `bson:"frequency" json:"frequency"`
+	Date       time.Time     `bson:"date" json:"date"`
+	Value      float64       `bson:"value" json:"value"`
+	CreatedAt  time.Time     `bson:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time     `bson:"updatedAt" json:"updatedAt"`
 }
 
 type StatCategory string
@@ -35,6 +52,10 @@ const (
 	StatCategoryISKLost     StatCategory = "isk_lost"
 	StatCategoryShipsKilled StatCategory = "ships_killed"
 	StatCategoryShipsLost   StatCategory = "ships_lost"
+	StatCategorySoloKills   StatCategory = "solo_kills"
+	// StatCategoryAttackers sums the player attackers on every kill, which divided by the ships killed is the
+	// average gang size
+	StatCategoryAttackers StatCategory = "attackers"
 )
 
 var AllStatCategorys = []StatCategory{
@@ -42,11 +63,14 @@ var AllStatCategorys = []StatCategory{
 	StatCategoryISKLost,
 	StatCategoryShipsKilled,
 	StatCategoryShipsLost,
+	StatCategorySoloKills,
+	StatCategoryAttackers,
 }
 
 func (e StatCategory) IsValid() bool {
 	switch e {
-	case StatCategoryISKKilled, StatCategoryISKLost, StatCategoryShipsKilled, StatCategoryShipsLost:
+	case StatCategoryISKKilled, StatCategoryISKLost, StatCategoryShipsKilled, StatCategoryShipsLost,
+		StatCategorySoloKills, StatCategoryAttackers:
 		return true
 	}
 	return false
@@ -127,6 +151,37 @@ func (e StatEntity) String() string {
 	return string(e)
 }
 
+// InvolvementColumn returns the column of the entity on the attackers and victim of a killmail. Locations are
+// not involved in a killmail through its attackers or victim and have no such column
+func (e StatEntity) InvolvementColumn() (string, bool) {
+	switch e {
+	case StatEntityCharacter:
+		return "characterID", true
+	case StatEntityCorporation:
+		return "corporationID", true
+	case StatEntityAlliance:
+		return "allianceID", true
+	case StatEntityShip:
+		return "shipTypeID", true
+	case StatEntityShipGroup:
+		return "shipGroupID", true
+	}
+	return "", false
+}
+
+// LocationColumn returns the column of a location entity on a killmail
+func (e StatEntity) LocationColumn() (string, bool) {
+	switch e {
+	case StatEntitySystem:
+		return "solarSystemID", true
+	case StatEntityConstellation:
+		return "constellationID", true
+	case StatEntityRegion:
+		return "regionID", true
+	}
+	return "", false
+}
+
 func (e *StatEntity) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
@@ -164,10 +219,10 @@ func (e *StatEntity) Value() (driver.Value, error) {
 type StatFrequency string
 
 const (
-	StatFrequencyDaily   = "daily"
-	StatFrequencyMonthly = "monthly"
-	StatFrequencyYearly  = "yearly"
-	StatFrequencyAlltime = "alltime"
+	StatFrequencyDaily   StatFrequency = "daily"
+	StatFrequencyMonthly StatFrequency = "monthly"
+	StatFrequencyYearly  StatFrequency = "yearly"
+	StatFrequencyAlltime StatFrequency = "alltime"
 )
 
 var AllStatFrequencys = []StatFrequency{
@@ -189,6 +244,24 @@ func (e StatFrequency) String() string {
 	return string(e)
 }
 
+// Truncate returns the date of the counter of this frequency that t falls into
+func (e StatFrequency) Truncate(t time.Time) time.Time {
+
+	t = t.UTC()
+
+	switch e {
+	case StatFrequencyDaily:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case StatFrequencyMonthly:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case StatFrequencyYearly:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Time{}
+
+}
+
 func (e *StatFrequency) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
@@ -222,3 +295,82 @@ func (e *StatFrequency) Scan(v interface{}) {
 func (e *StatFrequency) Value() (driver.Value, error) {
 	return e.String(), nil
 }
+
+// StatPeriod is the window of time that entity stats cover. Periods that line up with a stat frequency are read
+// from the counters, the rolling periods are aggregated from the killmails
+type StatPeriod string
+
+const (
+	StatPeriodToday      StatPeriod = "today"
+	StatPeriodLast7Days  StatPeriod = "last7Days"
+	StatPeriodLast30Days StatPeriod = "last30Days"
+	StatPeriodThisMonth  StatPeriod = "thisMonth"
+	StatPeriodThisYear   StatPeriod = "thisYear"
+	StatPeriodAllTime    StatPeriod = "allTime"
+)
+
+var AllStatPeriods = []StatPeriod{
+	StatPeriodToday,
+	StatPeriodLast7Days,
+	StatPeriodLast30Days,
+	StatPeriodThisMonth,
+	StatPeriodThisYear,
+	StatPeriodAllTime,
+}
+
+func (e StatPeriod) IsValid() bool {
+	switch e {
+	case StatPeriodToday, StatPeriodLast7Days, StatPeriodLast30Days, StatPeriodThisMonth, StatPeriodThisYear, StatPeriodAllTime:
+		return true
+	}
+	return false
+}
+
+func (e StatPeriod) String() string {
+	return string(e)
+}
+
+// Frequency returns the frequency of the counters that cover the period, if there is one
+func (e StatPeriod) Frequency() (StatFrequency, bool) {
+	switch e {
+	case StatPeriodToday:
+		return StatFrequencyDaily, true
+	case StatPeriodThisMonth:
+		return StatFrequencyMonthly, true
+	case StatPeriodThisYear:
+		return StatFrequencyYearly, true
+	case StatPeriodAllTime:
+		return StatFrequencyAlltime, true
+	}
+	return "", false
+}
+
+// Since returns the start of the period relative to now
+func (e StatPeriod) Since(now time.Time) time.Time {
+	switch e {
+	case StatPeriodLast7Days:
+		return now.AddDate(0, 0, -7)
+	case StatPeriodLast30Days:
+		return now.AddDate(0, 0, -30)
+	}
+
+	frequency, _ := e.Frequency()
+	return frequency.Truncate(now)
+}
+
+func (e *StatPeriod) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatPeriod", str)
+	}
+	return nil
+}
+
+func (e StatPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -9,20 +9,39 @@ import (
 
 type TokenRepository interface {
 	Token(ctx context.Context, id uint64) (*Token, error)
+	Tokens(ctx context.Context, operators ...*Operator) ([]*Token, error)
+	// AccountTokens returns the tokens of the main and every alt linked to it
+	AccountTokens(ctx context.Context, main uint64) ([]*Token, error)
 	CreateToken(ctx context.Context, token *Token) (*Token, error)
 	UpdateToken(ctx context.Context, id uint64, token *Token) (*Token, error)
 	DeleteToken(ctx context.Context, id uint64) error
 }
 
 type Token struct {
-	ID                uint64    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Main              uint64    `boil:"main" json:"main" toml:"main" yaml:"main"`
-	AccessToken       string    `boil:"access_token" json:"accessToken" toml:"accessToken" yaml:"accessToken"`
-	RefreshToken      string    `boil:"refresh_token" json:"refreshToken" toml:"refreshToken" yaml:"refreshToken"`
-	Expiry            time.Time `boil:"expiry" json:"expiry" toml:"expiry" yaml:"expiry"`
-	Disabled          bool      `boil:"disabled" json:"disabled" toml:"disabled" yaml:"disabled"`
-	DisabledTimestamp null.Time `boil:"disabled_timestamp" json:"disabledTimestamp,omitempty" toml:"disabledTimestamp" yaml:"disabledTimestamp,omitempty"`
-	DisabledReason    string    `boil:"disabled_reason" json:"disabledReason" toml:"disabledReason" yaml:"disabledReason"`
-	CreatedAt         time.Time `boil:"created_at" json:"createdAt" toml:"createdAt" yaml:"createdAt"`
-	UpdatedAt         time.Time `boil:"updated_at" json:"updatedAt" toml:"updatedAt" yaml:"updatedAt"`
+	ID                uint64    `bson:"id" json:"id"`
+	Main              uint64    `bson:"main" json:"main"`
+	AccessToken       string    `bson:"accessToken" json:"accessToken"`
+	RefreshToken      string    `bson:"refreshToken" json:"refreshToken"`
+	Expiry            time.Time `bson:"expiry" json:"expiry"`
+	Scopes            []string  `bson:"scopes" json:"scopes"`
+	Disabled          bool      `bson:"disabled" json:"disabled"`
+	DisabledTimestamp null.Time `bson:"disabledTimestamp" json:"disabledTimestamp,omitempty"`
+	DisabledReason    string    `bson:"disabledReason" json:"disabledReason"`
+	CreatedAt         time.Time `bson:"createdAt" json:"createdAt"`
+	UpdatedAt         time.Time `bson:"updatedAt" json:"updatedAt"`
 }
+
+// HasScope reports whether the token was granted the scope
+func (t *Token) HasScope(scope string) bool {
+	for _, s := range t.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	ScopeReadKillmails            = "esi-killmails.read_killmails.v1"
+	ScopeReadCorporationKillmails = "esi-killmails.read_corporation_killmails.v1"
+)
@@ -0,0 +1,8 @@
+package neo
+
+// Viewer is the character that an authenticated request was made on behalf of. It is resolved from the
+// session token the API issues after a successful SSO login
+type Viewer struct {
+	CharacterID uint64 `json:"characterID"`
+	SessionID   string `json:"-"`
+}
@@ -0,0 +1,72 @@
+package neo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type WatchlistRepository interface {
+	Watchlist(ctx context.Context, accountID uint64) ([]*WatchlistEntry, error)
+	CountWatchlist(ctx context.Context, accountID uint64) (int64, error)
+	Watch(ctx context.Context, entry *WatchlistEntry) error
+	Unwatch(ctx context.Context, accountID uint64, entity WatchEntity, entityID uint64) (bool, error)
+}
+
+// WatchlistEntry is an entity followed by an account. Watchlists belong to the account, identified by the
+// character id of its main, so every alt on the account shares the same watchlist
+type WatchlistEntry struct {
+	AccountID uint64      `bson:"accountID" json:"accountID"`
+	Entity    WatchEntity `bson:"entity" json:"entity"`
+	EntityID  uint64      `bson:"entityID" json:"entityID"`
+	CreatedAt time.Time   `bson:"createdAt" json:"createdAt"`
+}
+
+type WatchEntity string
+
+const (
+	WatchEntityCharacter   WatchEntity = "character"
+	WatchEntityCorporation WatchEntity = "corporation"
+	WatchEntityAlliance    WatchEntity = "alliance"
+	WatchEntitySystem      WatchEntity = "system"
+	WatchEntityShip        WatchEntity = "ship"
+)
+
+var AllWatchEntities = []WatchEntity{
+	WatchEntityCharacter,
+	WatchEntityCorporation,
+	WatchEntityAlliance,
+	WatchEntitySystem,
+	WatchEntityShip,
+}
+
+func (e WatchEntity) IsValid() bool {
+	switch e {
+	case WatchEntityCharacter, WatchEntityCorporation, WatchEntityAlliance, WatchEntitySystem, WatchEntityShip:
+		return true
+	}
+	return false
+}
+
+func (e WatchEntity) String() string {
+	return string(e)
+}
+
+func (e *WatchEntity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WatchEntity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WatchEntity", str)
+	}
+	return nil
+}
+
+func (e WatchEntity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
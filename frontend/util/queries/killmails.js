@@ -165,64 +165,76 @@ const KILLMAIL = gql`
 `;
 
 const KILLMAILS = gql`
-    query Killmails($entity: Entity!, $id: Int!, $page: Int = 1) {
-        killmails: killmailsByEntityID(entity: $entity, id: $id, page: $page) {
-            id
-            hash
-            killmailTime
-            totalValue
-            system {
-                id
-                name
-                security
-                constellation {
+    query Killmails($entity: Entity!, $id: Int!, $after: String, $before: String) {
+        killmails: killmailsByEntityID(entity: $entity, id: $id, after: $after, before: $before) {
+            totalCount
+            pageInfo {
+                hasNextPage
+                hasPreviousPage
+                startCursor
+                endCursor
+            }
+            edges {
+                cursor
+                node {
                     id
-                    name
-                    region {
+                    hash
+                    killmailTime
+                    totalValue
+                    system {
                         id
                         name
+                        security
+                        constellation {
+                            id
+                            name
+                            region {
+                                id
+                                name
+                            }
+                        }
+                    }
+                    attackers {
+                        character {
+                            id
+                            name
+                        }
+                        alliance {
+                            id
+                            name
+                        }
+                        corporation {
+                            id
+                            name
+                        }
+                        ship {
+                            id
+                            name
+                        }
+                        weapon {
+                            id
+                            name
+                        }
+                        finalBlow
+                    }
+                    victim {
+                        character {
+                            id
+                            name
+                        }
+                        alliance {
+                            id
+                            name
+                        }
+                        corporation {
+                            id
+                            name
+                        }
+                        ship {
+                            id
+                            name
+                        }
                     }
-                }
-            }
-            attackers {
-                character {
-                    id
-                    name
-                }
-                alliance {
-                    id
-                    name
-                }
-                corporation {
-                    id
-                    name
-                }
-                ship {
-                    id
-                    name
-                }
-                weapon {
-                    id
-                    name
-                }
-                finalBlow
-            }
-            victim {
-                character {
-                    id
-                    name
-                }
-                alliance {
-                    id
-                    name
-                }
-                corporation {
-                    id
-                    name
-                }
-                ship {
-                    id
-                    name
                 }
             }
         }